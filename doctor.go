@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	flygql "dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/wg"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/miekg/dns"
+)
+
+type doctorCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Message string `json:"message"`
+}
+
+type doctorReport struct {
+	Version string        `json:"version"`
+	Checks  []doctorCheck `json:"checks"`
+}
+
+func (r *doctorReport) pass(name string, message string) {
+	r.Checks = append(r.Checks, doctorCheck{Name: name, Passed: true, Message: message})
+}
+
+func (r *doctorReport) fail(name string, err error) {
+	r.Checks = append(r.Checks, doctorCheck{Name: name, Message: err.Error()})
+}
+
+func (r *doctorReport) skip(name string, message string) {
+	r.Checks = append(r.Checks, doctorCheck{Name: name, Skipped: true, Message: message})
+}
+
+func (r *doctorReport) failed() bool {
+	for _, check := range r.Checks {
+		if !check.Passed && !check.Skipped {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *doctorReport) print() {
+	for _, check := range r.Checks {
+		status := "FAIL"
+		if check.Passed {
+			status = "PASS"
+		} else if check.Skipped {
+			status = "SKIP"
+		}
+		fmt.Printf("%-4s  %-10s  %s\n", status, check.Name, check.Message)
+	}
+}
+
+// runDoctor walks through everything the provider needs, in the order the provider needs it, and prints a report.
+// Checks that depend on an earlier failed one are skipped.
+func runDoctor(args []string) error {
+	fs := flag.NewFlagSet("doctor", flag.ExitOnError)
+	org := fs.String("org", "", "org slug or ID, defaults to your only org")
+	region := fs.String("region", "", "region of the wireguard gateway to test, wireguard checks are skipped without it")
+	upload := fs.Bool("upload", false, "upload the report to fly.io and print its ID for support tickets")
+	fs.Parse(args)
+
+	ctx := context.Background()
+	report := doctorReport{Version: version}
+	defer report.print()
+
+	client, token, err := apiClient(ctx)
+	if err != nil {
+		report.fail("token", err)
+		return errors.New("doctor found problems")
+	}
+	report.pass("token", "FLY_TOKEN is set")
+
+	runDoctorChecks(ctx, &report, client, token, *org, *region)
+
+	if *upload {
+		resp, err := flygql.CreateDoctorReport(ctx, client, report)
+		if err != nil {
+			report.fail("upload", err)
+		} else {
+			report.pass("upload", "report ID "+resp.CreateDoctorReport.ReportId)
+		}
+	}
+
+	if report.failed() {
+		return errors.New("doctor found problems")
+	}
+	return nil
+}
+
+func runDoctorChecks(ctx context.Context, report *doctorReport, client graphql.Client, token string, org string, region string) {
+	viewer, err := flygql.ViewerQuery(ctx, client)
+	if err != nil {
+		report.fail("graphql", err)
+		report.skip("org", "needs graphql")
+		report.skip("wireguard", "needs graphql")
+		report.skip("dns", "needs wireguard")
+		report.skip("machines", "needs wireguard")
+		return
+	}
+	report.pass("graphql", "authenticated as "+viewer.Viewer.Email)
+
+	orgId, err := resolveOrgFlag(client, org)
+	if err != nil {
+		report.fail("org", err)
+		report.skip("wireguard", "needs org")
+		report.skip("dns", "needs wireguard")
+		report.skip("machines", "needs wireguard")
+		return
+	}
+	report.pass("org", "using "+orgId)
+
+	if region == "" {
+		report.skip("wireguard", "pass --region to test the tunnel")
+		report.skip("dns", "needs wireguard")
+		report.skip("machines", "needs wireguard")
+		return
+	}
+
+	// No store, so a throwaway peer is registered and removed again which exercises the whole peer lifecycle
+	start := time.Now()
+	tunnel, err := wg.Establish(ctx, orgId, region, token, &client, wg.EstablishOptions{Email: viewer.Viewer.Email})
+	if err != nil {
+		report.fail("wireguard", err)
+		report.skip("dns", "needs wireguard")
+		report.skip("machines", "needs wireguard")
+		return
+	}
+	report.pass("wireguard", fmt.Sprintf("peer %s handshake in %s", tunnel.State.Name, time.Since(start).Round(time.Millisecond)))
+	defer func() {
+		if err := tunnel.Down(); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+		}
+	}()
+
+	msg := new(dns.Msg)
+	msg.SetQuestion("_apps.internal.", dns.TypeTXT)
+	answer, err := tunnel.QueryDNS(ctx, msg)
+	if err != nil {
+		report.fail("dns", err)
+	} else if answer.Rcode != dns.RcodeSuccess {
+		report.fail("dns", fmt.Errorf("_apps.internal returned %s", dns.RcodeToString[answer.Rcode]))
+	} else {
+		report.pass("dns", fmt.Sprintf("_apps.internal returned %d records", len(answer.Answer)))
+	}
+
+	machines := http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &http.Transport{DialContext: tunnel.DialContext, DisableKeepAlives: true},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://_api.internal:4280/v1/apps", nil)
+	if err != nil {
+		report.fail("machines", err)
+		return
+	}
+	req.Header.Add("Authorization", "Bearer "+token)
+	resp, err := machines.Do(req)
+	if err != nil {
+		report.fail("machines", err)
+		return
+	}
+	resp.Body.Close()
+	// Any answer means the api is reachable, the endpoint itself doesn't matter
+	report.pass("machines", "_api.internal:4280 answered "+resp.Status)
+}
@@ -0,0 +1,3 @@
+data "fly_app_release" "current" {
+  app = "hellofromterraform"
+}
@@ -0,0 +1,11 @@
+data "fly_app_releases" "recent" {
+  app   = "hellofromterraform"
+  limit = 10
+}
+
+# Roll back to the newest stable release before the current one
+resource "fly_deployment" "exampleApp" {
+  app         = "hellofromterraform"
+  image       = "registry.fly.io/hellofromterraform:v2"
+  rollback_to = [for r in data.fly_app_releases.recent.releases : r.version if r.stable][1]
+}
@@ -0,0 +1,10 @@
+data "fly_check_job_run" "exampleApp" {
+  check_job = fly_check_job.exampleApp.id
+
+  lifecycle {
+    postcondition {
+      condition     = self.passing
+      error_message = "The health check of hellofromterraform is failing."
+    }
+  }
+}
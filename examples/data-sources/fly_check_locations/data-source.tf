@@ -0,0 +1 @@
+data "fly_check_locations" "all" {}
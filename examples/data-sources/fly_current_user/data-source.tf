@@ -0,0 +1,5 @@
+data "fly_current_user" "me" {}
+
+output "email" {
+  value = data.fly_current_user.me.email
+}
@@ -0,0 +1,3 @@
+data "fly_organization" "team" {
+  slug = "my-team"
+}
@@ -0,0 +1,4 @@
+data "fly_postgres_attachments" "exampleApp" {
+  postgres_app = "hellofromterraform-db"
+  app          = "hellofromterraform"
+}
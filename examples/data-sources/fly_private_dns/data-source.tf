@@ -0,0 +1,10 @@
+data "fly_private_dns" "instances" {
+  region = "ewr"
+  name   = "flyiac.internal"
+}
+
+data "fly_private_dns" "apps" {
+  region = "ewr"
+  name   = "_apps.internal"
+  type   = "TXT"
+}
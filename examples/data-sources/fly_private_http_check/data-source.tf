@@ -0,0 +1,7 @@
+data "fly_private_http_check" "api" {
+  region          = "ewr"
+  url             = "http://flyiac.internal:8080/health"
+  expected_status = 200
+  retries         = 5
+  timeout         = 5
+}
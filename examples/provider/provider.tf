@@ -1,4 +1,5 @@
 provider "scaffolding" {
   # Please don't do this. Use the FLY_TOKEN env variable instead.
   flytoken = "abc123"
+  org      = "personal"
 }
@@ -0,0 +1 @@
+terraform import fly_app_autoscaling.exampleApp hellofromterraform
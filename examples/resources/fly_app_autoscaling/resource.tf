@@ -0,0 +1,12 @@
+resource "fly_app_autoscaling" "exampleApp" {
+  app             = fly_app.exampleApp.name
+  enabled         = true
+  balance_regions = true
+  min_count       = 2
+  max_count       = 10
+
+  region_min_counts = {
+    ewr = 1
+    lax = 1
+  }
+}
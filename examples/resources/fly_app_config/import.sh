@@ -0,0 +1 @@
+terraform import fly_app_config.exampleApp hellofromterraform
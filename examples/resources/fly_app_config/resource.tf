@@ -0,0 +1,6 @@
+resource "fly_app_config" "exampleApp" {
+  app    = fly_app.exampleApp.name
+  config = file("${path.module}/fly.toml")
+
+  depends_on = [fly_deployment.exampleApp]
+}
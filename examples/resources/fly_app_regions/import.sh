@@ -0,0 +1 @@
+terraform import fly_app_regions.web hellofromterraform/web
@@ -0,0 +1,6 @@
+resource "fly_app_regions" "web" {
+  app            = fly_app.exampleApp.name
+  group          = "web"
+  allow_regions  = ["ewr", "ord"]
+  backup_regions = ["iad"]
+}
@@ -0,0 +1 @@
+terraform import fly_app_scale.web hellofromterraform/app
@@ -0,0 +1,12 @@
+resource "fly_app_scale" "web" {
+  app            = fly_app.exampleApp.name
+  vm_size        = "shared-cpu-2x"
+  memory_mb      = 1024
+  count          = 3
+  max_per_region = 2
+
+  region_counts = {
+    ewr = 2
+    lax = 1
+  }
+}
@@ -0,0 +1,14 @@
+data "fly_check_locations" "all" {}
+
+resource "fly_check_job" "exampleApp" {
+  url       = "https://hellofromterraform.fly.dev/health"
+  locations = slice(data.fly_check_locations.all.names, 0, 3)
+
+  headers = {
+    "User-Agent" = "fly-check"
+  }
+
+  run_trigger = {
+    release = fly_deployment.exampleApp.release_id
+  }
+}
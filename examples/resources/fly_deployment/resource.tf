@@ -0,0 +1,17 @@
+resource "fly_deployment" "exampleApp" {
+  app      = fly_app.exampleApp.name
+  image    = "registry.fly.io/hellofromterraform:v2"
+  strategy = "rolling"
+  timeout  = 300
+
+  definition = jsonencode({
+    services = [{
+      protocol      = "tcp"
+      internal_port = 8080
+      ports = [
+        { port = 80, handlers = ["http"] },
+        { port = 443, handlers = ["tls", "http"] },
+      ]
+    }]
+  })
+}
@@ -0,0 +1,2 @@
+# Organizations can be imported by ID or slug
+terraform import fly_organization.team my-team
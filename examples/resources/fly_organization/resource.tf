@@ -0,0 +1,3 @@
+resource "fly_organization" "team" {
+  name = "my-team"
+}
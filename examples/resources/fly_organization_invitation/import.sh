@@ -0,0 +1 @@
+terraform import fly_organization_invitation.alice my-team/<invitation id>
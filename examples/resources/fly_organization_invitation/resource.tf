@@ -0,0 +1,4 @@
+resource "fly_organization_invitation" "alice" {
+  org   = fly_organization.team.slug
+  email = "alice@example.com"
+}
@@ -0,0 +1 @@
+terraform import fly_organization_member.alice my-team/alice@example.com
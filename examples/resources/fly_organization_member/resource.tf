@@ -0,0 +1,5 @@
+resource "fly_organization_member" "alice" {
+  org   = fly_organization.team.slug
+  email = "alice@example.com"
+  role  = "admin"
+}
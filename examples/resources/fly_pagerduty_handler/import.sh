@@ -0,0 +1 @@
+terraform import fly_pagerduty_handler.oncall my-org/oncall
@@ -0,0 +1,9 @@
+resource "fly_pagerduty_handler" "oncall" {
+  name  = "oncall"
+  token = var.pagerduty_token
+
+  status_map = {
+    critical = "error"
+    warning  = "warning"
+  }
+}
@@ -0,0 +1 @@
+terraform import fly_postgres_attachment.exampleApp hellofromterraform-db/hellofromterraform/DATABASE_URL
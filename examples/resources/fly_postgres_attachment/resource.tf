@@ -0,0 +1,5 @@
+resource "fly_postgres_attachment" "exampleApp" {
+  postgres_app  = "hellofromterraform-db"
+  app           = fly_app.exampleApp.name
+  database_name = "hello"
+}
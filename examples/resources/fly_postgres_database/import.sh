@@ -0,0 +1 @@
+terraform import fly_postgres_database.orders hellofromterraform-db/orders
@@ -0,0 +1,4 @@
+resource "fly_postgres_database" "orders" {
+  app  = "hellofromterraform-db"
+  name = "orders"
+}
@@ -0,0 +1 @@
+terraform import fly_postgres_grant.orders hellofromterraform-db/orders/orders
@@ -0,0 +1,5 @@
+resource "fly_postgres_grant" "orders" {
+  app      = "hellofromterraform-db"
+  username = fly_postgres_user.orders.username
+  database = fly_postgres_database.orders.name
+}
@@ -0,0 +1 @@
+terraform import fly_postgres_user.orders hellofromterraform-db/orders
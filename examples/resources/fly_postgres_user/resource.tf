@@ -0,0 +1,10 @@
+resource "random_password" "orders" {
+  length  = 32
+  special = false
+}
+
+resource "fly_postgres_user" "orders" {
+  app      = "hellofromterraform-db"
+  username = "orders"
+  password = random_password.orders.result
+}
@@ -0,0 +1 @@
+terraform import fly_slack_handler.alerts my-org/alerts
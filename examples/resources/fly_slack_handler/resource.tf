@@ -0,0 +1,6 @@
+resource "fly_slack_handler" "alerts" {
+  name        = "alerts"
+  webhook_url = var.slack_webhook_url
+  channel     = "#alerts"
+  username    = "fly"
+}
@@ -0,0 +1,9 @@
+resource "fly_wireguard_peer" "ci" {
+  name   = "ci-runner"
+  region = "ewr"
+}
+
+resource "local_sensitive_file" "wg_config" {
+  filename = "fly.conf"
+  content  = fly_wireguard_peer.ci.config
+}
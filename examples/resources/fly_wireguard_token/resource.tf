@@ -0,0 +1,8 @@
+resource "fly_wireguard_token" "bastion" {
+  name = "bastion"
+
+  # Change to rotate the token
+  keepers = {
+    rotated = "2022-06-01"
+  }
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 )
@@ -200,634 +201,1062 @@ func (v *AllocateIpAddressResponse) GetAllocateIpAddress() AllocateIpAddressAllo
 	return v.AllocateIpAddress
 }
 
-// Region autoscaling configuration
-type AutoscaleRegionConfigInput struct {
-	// The region code to configure
-	Code string `json:"code"`
-	// The weight
-	Weight int `json:"weight"`
-	// Minimum number of VMs to run in this region
-	MinCount int `json:"minCount"`
-	// Reset the configuration for this region
-	Reset bool `json:"reset"`
+// AppAutoscalingQueryApp includes the requested fields of the GraphQL type App.
+type AppAutoscalingQueryApp struct {
+	// The unique application name
+	Name        string                                             `json:"name"`
+	Autoscaling AppAutoscalingQueryAppAutoscalingAutoscalingConfig `json:"autoscaling"`
 }
 
-// GetCode returns AutoscaleRegionConfigInput.Code, and is useful for accessing the field via an interface.
-func (v *AutoscaleRegionConfigInput) GetCode() string { return v.Code }
-
-// GetWeight returns AutoscaleRegionConfigInput.Weight, and is useful for accessing the field via an interface.
-func (v *AutoscaleRegionConfigInput) GetWeight() int { return v.Weight }
-
-// GetMinCount returns AutoscaleRegionConfigInput.MinCount, and is useful for accessing the field via an interface.
-func (v *AutoscaleRegionConfigInput) GetMinCount() int { return v.MinCount }
-
-// GetReset returns AutoscaleRegionConfigInput.Reset, and is useful for accessing the field via an interface.
-func (v *AutoscaleRegionConfigInput) GetReset() bool { return v.Reset }
+// GetName returns AppAutoscalingQueryApp.Name, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryApp) GetName() string { return v.Name }
 
-// CreateAppMutationCreateAppCreateAppPayload includes the requested fields of the GraphQL type CreateAppPayload.
-// The GraphQL type's documentation follows.
-//
-// Autogenerated return type of CreateApp
-type CreateAppMutationCreateAppCreateAppPayload struct {
-	App CreateAppMutationCreateAppCreateAppPayloadApp `json:"app"`
+// GetAutoscaling returns AppAutoscalingQueryApp.Autoscaling, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryApp) GetAutoscaling() AppAutoscalingQueryAppAutoscalingAutoscalingConfig {
+	return v.Autoscaling
 }
 
-// GetApp returns CreateAppMutationCreateAppCreateAppPayload.App, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationCreateAppCreateAppPayload) GetApp() CreateAppMutationCreateAppCreateAppPayloadApp {
-	return v.App
+// AppAutoscalingQueryAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
+type AppAutoscalingQueryAppAutoscalingAutoscalingConfig struct {
+	Enabled        bool                                                                             `json:"enabled"`
+	BalanceRegions bool                                                                             `json:"balanceRegions"`
+	MinCount       int                                                                              `json:"minCount"`
+	MaxCount       int                                                                              `json:"maxCount"`
+	Strategy       AutoscaleStrategy                                                                `json:"strategy"`
+	Regions        []AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig `json:"regions"`
 }
 
-// CreateAppMutationCreateAppCreateAppPayloadApp includes the requested fields of the GraphQL type App.
-type CreateAppMutationCreateAppCreateAppPayloadApp struct {
-	// The unique application name
-	Name string `json:"name"`
-	// Application status
-	Status      string                                                                    `json:"status"`
-	Autoscaling CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig `json:"autoscaling"`
-	// Organization that owns this app
-	Organization CreateAppMutationCreateAppCreateAppPayloadAppOrganization `json:"organization"`
-	Network      string                                                    `json:"network"`
+// GetEnabled returns AppAutoscalingQueryAppAutoscalingAutoscalingConfig.Enabled, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryAppAutoscalingAutoscalingConfig) GetEnabled() bool { return v.Enabled }
+
+// GetBalanceRegions returns AppAutoscalingQueryAppAutoscalingAutoscalingConfig.BalanceRegions, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryAppAutoscalingAutoscalingConfig) GetBalanceRegions() bool {
+	return v.BalanceRegions
 }
 
-// GetName returns CreateAppMutationCreateAppCreateAppPayloadApp.Name, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetName() string { return v.Name }
+// GetMinCount returns AppAutoscalingQueryAppAutoscalingAutoscalingConfig.MinCount, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryAppAutoscalingAutoscalingConfig) GetMinCount() int { return v.MinCount }
 
-// GetStatus returns CreateAppMutationCreateAppCreateAppPayloadApp.Status, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetStatus() string { return v.Status }
+// GetMaxCount returns AppAutoscalingQueryAppAutoscalingAutoscalingConfig.MaxCount, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryAppAutoscalingAutoscalingConfig) GetMaxCount() int { return v.MaxCount }
 
-// GetAutoscaling returns CreateAppMutationCreateAppCreateAppPayloadApp.Autoscaling, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetAutoscaling() CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig {
-	return v.Autoscaling
+// GetStrategy returns AppAutoscalingQueryAppAutoscalingAutoscalingConfig.Strategy, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryAppAutoscalingAutoscalingConfig) GetStrategy() AutoscaleStrategy {
+	return v.Strategy
 }
 
-// GetOrganization returns CreateAppMutationCreateAppCreateAppPayloadApp.Organization, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetOrganization() CreateAppMutationCreateAppCreateAppPayloadAppOrganization {
-	return v.Organization
+// GetRegions returns AppAutoscalingQueryAppAutoscalingAutoscalingConfig.Regions, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryAppAutoscalingAutoscalingConfig) GetRegions() []AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig {
+	return v.Regions
 }
 
-// GetNetwork returns CreateAppMutationCreateAppCreateAppPayloadApp.Network, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetNetwork() string { return v.Network }
-
-// CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
-type CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig struct {
-	PreferredRegion string `json:"preferredRegion"`
+// AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig includes the requested fields of the GraphQL type AutoscaleRegionConfig.
+type AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig struct {
+	// The region code
+	Code string `json:"code"`
+	// The minimum number of VMs to run in this region
+	MinCount int `json:"minCount"`
+	// The relative weight for this region
+	Weight int `json:"weight"`
 }
 
-// GetPreferredRegion returns CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig.PreferredRegion, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig) GetPreferredRegion() string {
-	return v.PreferredRegion
+// GetCode returns AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig.Code, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) GetCode() string {
+	return v.Code
 }
 
-// CreateAppMutationCreateAppCreateAppPayloadAppOrganization includes the requested fields of the GraphQL type Organization.
-type CreateAppMutationCreateAppCreateAppPayloadAppOrganization struct {
-	Id string `json:"id"`
+// GetMinCount returns AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig.MinCount, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) GetMinCount() int {
+	return v.MinCount
 }
 
-// GetId returns CreateAppMutationCreateAppCreateAppPayloadAppOrganization.Id, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationCreateAppCreateAppPayloadAppOrganization) GetId() string { return v.Id }
+// GetWeight returns AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig.Weight, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) GetWeight() int {
+	return v.Weight
+}
 
-// CreateAppMutationResponse is returned by CreateAppMutation on success.
-type CreateAppMutationResponse struct {
-	CreateApp CreateAppMutationCreateAppCreateAppPayload `json:"createApp"`
+// AppAutoscalingQueryResponse is returned by AppAutoscalingQuery on success.
+type AppAutoscalingQueryResponse struct {
+	// Find an app by name
+	App AppAutoscalingQueryApp `json:"app"`
 }
 
-// GetCreateApp returns CreateAppMutationResponse.CreateApp, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationResponse) GetCreateApp() CreateAppMutationCreateAppCreateAppPayload {
-	return v.CreateApp
+// GetApp returns AppAutoscalingQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *AppAutoscalingQueryResponse) GetApp() AppAutoscalingQueryApp { return v.App }
+
+// AppConfigQueryApp includes the requested fields of the GraphQL type App.
+type AppConfigQueryApp struct {
+	Config AppConfigQueryAppConfig `json:"config"`
 }
 
-// CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload includes the requested fields of the GraphQL type CreateAppPayload.
-// The GraphQL type's documentation follows.
-//
-// Autogenerated return type of CreateApp
-type CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload struct {
-	App CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp `json:"app"`
+// GetConfig returns AppConfigQueryApp.Config, and is useful for accessing the field via an interface.
+func (v *AppConfigQueryApp) GetConfig() AppConfigQueryAppConfig { return v.Config }
+
+// AppConfigQueryAppConfig includes the requested fields of the GraphQL type AppConfig.
+type AppConfigQueryAppConfig struct {
+	Definition interface{} `json:"definition"`
 }
 
-// GetApp returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload.App, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload) GetApp() CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp {
-	return v.App
+// GetDefinition returns AppConfigQueryAppConfig.Definition, and is useful for accessing the field via an interface.
+func (v *AppConfigQueryAppConfig) GetDefinition() interface{} { return v.Definition }
+
+// AppConfigQueryResponse is returned by AppConfigQuery on success.
+type AppConfigQueryResponse struct {
+	// Find an app by name
+	App AppConfigQueryApp `json:"app"`
 }
 
-// CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp includes the requested fields of the GraphQL type App.
-type CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp struct {
+// GetApp returns AppConfigQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *AppConfigQueryResponse) GetApp() AppConfigQueryApp { return v.App }
+
+// AppRegionsQueryApp includes the requested fields of the GraphQL type App.
+type AppRegionsQueryApp struct {
 	// The unique application name
-	Name string `json:"name"`
-	// Application status
-	Status      string                                                                                       `json:"status"`
-	Autoscaling CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig `json:"autoscaling"`
-	// Organization that owns this app
-	Organization CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization `json:"organization"`
-	Network      string                                                                       `json:"network"`
+	Name          string                                        `json:"name"`
+	Regions       []AppRegionsQueryAppRegionsRegion             `json:"regions"`
+	BackupRegions []AppRegionsQueryAppBackupRegionsRegion       `json:"backupRegions"`
+	ProcessGroups []AppRegionsQueryAppProcessGroupsProcessGroup `json:"processGroups"`
 }
 
-// GetName returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Name, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetName() string {
-	return v.Name
-}
+// GetName returns AppRegionsQueryApp.Name, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryApp) GetName() string { return v.Name }
 
-// GetStatus returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Status, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetStatus() string {
-	return v.Status
-}
+// GetRegions returns AppRegionsQueryApp.Regions, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryApp) GetRegions() []AppRegionsQueryAppRegionsRegion { return v.Regions }
 
-// GetAutoscaling returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Autoscaling, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetAutoscaling() CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig {
-	return v.Autoscaling
+// GetBackupRegions returns AppRegionsQueryApp.BackupRegions, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryApp) GetBackupRegions() []AppRegionsQueryAppBackupRegionsRegion {
+	return v.BackupRegions
 }
 
-// GetOrganization returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Organization, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetOrganization() CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization {
-	return v.Organization
+// GetProcessGroups returns AppRegionsQueryApp.ProcessGroups, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryApp) GetProcessGroups() []AppRegionsQueryAppProcessGroupsProcessGroup {
+	return v.ProcessGroups
 }
 
-// GetNetwork returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Network, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetNetwork() string {
-	return v.Network
+// AppRegionsQueryAppBackupRegionsRegion includes the requested fields of the GraphQL type Region.
+type AppRegionsQueryAppBackupRegionsRegion struct {
+	// The IATA airport code for this region
+	Code         string `json:"code"`
+	ProcessGroup string `json:"processGroup"`
 }
 
-// CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
-type CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig struct {
-	PreferredRegion string `json:"preferredRegion"`
-}
+// GetCode returns AppRegionsQueryAppBackupRegionsRegion.Code, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryAppBackupRegionsRegion) GetCode() string { return v.Code }
 
-// GetPreferredRegion returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig.PreferredRegion, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig) GetPreferredRegion() string {
-	return v.PreferredRegion
-}
+// GetProcessGroup returns AppRegionsQueryAppBackupRegionsRegion.ProcessGroup, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryAppBackupRegionsRegion) GetProcessGroup() string { return v.ProcessGroup }
 
-// CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization includes the requested fields of the GraphQL type Organization.
-type CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization struct {
-	Id string `json:"id"`
+// AppRegionsQueryAppProcessGroupsProcessGroup includes the requested fields of the GraphQL type ProcessGroup.
+type AppRegionsQueryAppProcessGroupsProcessGroup struct {
+	Name string `json:"name"`
 }
 
-// GetId returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization.Id, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization) GetId() string {
-	return v.Id
-}
+// GetName returns AppRegionsQueryAppProcessGroupsProcessGroup.Name, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryAppProcessGroupsProcessGroup) GetName() string { return v.Name }
 
-// CreateAppMutationWithAutoscaleConfigResponse is returned by CreateAppMutationWithAutoscaleConfig on success.
-type CreateAppMutationWithAutoscaleConfigResponse struct {
-	CreateApp             CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload                         `json:"createApp"`
-	UpdateAutoscaleConfig CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload `json:"updateAutoscaleConfig"`
+// AppRegionsQueryAppRegionsRegion includes the requested fields of the GraphQL type Region.
+type AppRegionsQueryAppRegionsRegion struct {
+	// The IATA airport code for this region
+	Code         string `json:"code"`
+	ProcessGroup string `json:"processGroup"`
 }
 
-// GetCreateApp returns CreateAppMutationWithAutoscaleConfigResponse.CreateApp, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigResponse) GetCreateApp() CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload {
-	return v.CreateApp
-}
+// GetCode returns AppRegionsQueryAppRegionsRegion.Code, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryAppRegionsRegion) GetCode() string { return v.Code }
 
-// GetUpdateAutoscaleConfig returns CreateAppMutationWithAutoscaleConfigResponse.UpdateAutoscaleConfig, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigResponse) GetUpdateAutoscaleConfig() CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload {
-	return v.UpdateAutoscaleConfig
-}
+// GetProcessGroup returns AppRegionsQueryAppRegionsRegion.ProcessGroup, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryAppRegionsRegion) GetProcessGroup() string { return v.ProcessGroup }
 
-// CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload includes the requested fields of the GraphQL type UpdateAutoscaleConfigPayload.
-// The GraphQL type's documentation follows.
-//
-// Autogenerated return type of UpdateAutoscaleConfig
-type CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload struct {
-	App CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp `json:"app"`
+// AppRegionsQueryResponse is returned by AppRegionsQuery on success.
+type AppRegionsQueryResponse struct {
+	// Find an app by name
+	App AppRegionsQueryApp `json:"app"`
 }
 
-// GetApp returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload.App, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload) GetApp() CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp {
-	return v.App
-}
+// GetApp returns AppRegionsQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *AppRegionsQueryResponse) GetApp() AppRegionsQueryApp { return v.App }
 
-// CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp includes the requested fields of the GraphQL type App.
-type CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp struct {
-	// Application status
-	Status      string                                                                                                               `json:"status"`
-	Autoscaling CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig `json:"autoscaling"`
+// AppScaleQueryApp includes the requested fields of the GraphQL type App.
+type AppScaleQueryApp struct {
+	// The unique application name
+	Name             string                                            `json:"name"`
+	VmSize           AppScaleQueryAppVmSizeVMSize                      `json:"vmSize"`
+	TaskGroupCounts  []AppScaleQueryAppTaskGroupCountsTaskGroupCount   `json:"taskGroupCounts"`
+	ProcessGroups    []AppScaleQueryAppProcessGroupsProcessGroup       `json:"processGroups"`
+	CurrentPlacement []AppScaleQueryAppCurrentPlacementRegionPlacement `json:"currentPlacement"`
 }
 
-// GetStatus returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp.Status, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp) GetStatus() string {
-	return v.Status
-}
+// GetName returns AppScaleQueryApp.Name, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryApp) GetName() string { return v.Name }
 
-// GetAutoscaling returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp.Autoscaling, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp) GetAutoscaling() CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig {
-	return v.Autoscaling
+// GetVmSize returns AppScaleQueryApp.VmSize, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryApp) GetVmSize() AppScaleQueryAppVmSizeVMSize { return v.VmSize }
+
+// GetTaskGroupCounts returns AppScaleQueryApp.TaskGroupCounts, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryApp) GetTaskGroupCounts() []AppScaleQueryAppTaskGroupCountsTaskGroupCount {
+	return v.TaskGroupCounts
 }
 
-// CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
-type CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig struct {
-	Regions []CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig `json:"regions"`
+// GetProcessGroups returns AppScaleQueryApp.ProcessGroups, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryApp) GetProcessGroups() []AppScaleQueryAppProcessGroupsProcessGroup {
+	return v.ProcessGroups
 }
 
-// GetRegions returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig.Regions, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig) GetRegions() []CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig {
-	return v.Regions
+// GetCurrentPlacement returns AppScaleQueryApp.CurrentPlacement, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryApp) GetCurrentPlacement() []AppScaleQueryAppCurrentPlacementRegionPlacement {
+	return v.CurrentPlacement
 }
 
-// CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig includes the requested fields of the GraphQL type AutoscaleRegionConfig.
-type CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig struct {
+// AppScaleQueryAppCurrentPlacementRegionPlacement includes the requested fields of the GraphQL type RegionPlacement.
+type AppScaleQueryAppCurrentPlacementRegionPlacement struct {
 	// The region code
-	Code string `json:"code"`
+	Region string `json:"region"`
+	// The desired number of allocations
+	Count int `json:"count"`
 }
 
-// GetCode returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig.Code, and is useful for accessing the field via an interface.
-func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) GetCode() string {
-	return v.Code
-}
+// GetRegion returns AppScaleQueryAppCurrentPlacementRegionPlacement.Region, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppCurrentPlacementRegionPlacement) GetRegion() string { return v.Region }
 
-// CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload includes the requested fields of the GraphQL type CreatePostgresClusterPayload.
-// The GraphQL type's documentation follows.
-//
-// Autogenerated return type of CreatePostgresCluster
-type CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload struct {
-	App      CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp `json:"app"`
-	Username string                                                                    `json:"username"`
-	Password string                                                                    `json:"password"`
-}
+// GetCount returns AppScaleQueryAppCurrentPlacementRegionPlacement.Count, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppCurrentPlacementRegionPlacement) GetCount() int { return v.Count }
 
-// GetApp returns CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload.App, and is useful for accessing the field via an interface.
-func (v *CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload) GetApp() CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp {
-	return v.App
+// AppScaleQueryAppProcessGroupsProcessGroup includes the requested fields of the GraphQL type ProcessGroup.
+type AppScaleQueryAppProcessGroupsProcessGroup struct {
+	Name         string                                                `json:"name"`
+	MaxPerRegion int                                                   `json:"maxPerRegion"`
+	VmSize       AppScaleQueryAppProcessGroupsProcessGroupVmSizeVMSize `json:"vmSize"`
 }
 
-// GetUsername returns CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload.Username, and is useful for accessing the field via an interface.
-func (v *CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload) GetUsername() string {
-	return v.Username
-}
+// GetName returns AppScaleQueryAppProcessGroupsProcessGroup.Name, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppProcessGroupsProcessGroup) GetName() string { return v.Name }
 
-// GetPassword returns CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload.Password, and is useful for accessing the field via an interface.
-func (v *CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload) GetPassword() string {
-	return v.Password
+// GetMaxPerRegion returns AppScaleQueryAppProcessGroupsProcessGroup.MaxPerRegion, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppProcessGroupsProcessGroup) GetMaxPerRegion() int { return v.MaxPerRegion }
+
+// GetVmSize returns AppScaleQueryAppProcessGroupsProcessGroup.VmSize, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppProcessGroupsProcessGroup) GetVmSize() AppScaleQueryAppProcessGroupsProcessGroupVmSizeVMSize {
+	return v.VmSize
 }
 
-// CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp includes the requested fields of the GraphQL type App.
-type CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp struct {
-	// The unique application name
-	Name string `json:"name"`
+// AppScaleQueryAppProcessGroupsProcessGroupVmSizeVMSize includes the requested fields of the GraphQL type VMSize.
+type AppScaleQueryAppProcessGroupsProcessGroupVmSizeVMSize struct {
+	Name     string `json:"name"`
+	MemoryMb int    `json:"memoryMb"`
 }
 
-// GetName returns CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp.Name, and is useful for accessing the field via an interface.
-func (v *CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp) GetName() string {
-	return v.Name
+// GetName returns AppScaleQueryAppProcessGroupsProcessGroupVmSizeVMSize.Name, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppProcessGroupsProcessGroupVmSizeVMSize) GetName() string { return v.Name }
+
+// GetMemoryMb returns AppScaleQueryAppProcessGroupsProcessGroupVmSizeVMSize.MemoryMb, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppProcessGroupsProcessGroupVmSizeVMSize) GetMemoryMb() int { return v.MemoryMb }
+
+// AppScaleQueryAppTaskGroupCountsTaskGroupCount includes the requested fields of the GraphQL type TaskGroupCount.
+type AppScaleQueryAppTaskGroupCountsTaskGroupCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
 }
 
-// CreatePostgresClusterResponse is returned by CreatePostgresCluster on success.
-type CreatePostgresClusterResponse struct {
-	CreatePostgresCluster CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload `json:"createPostgresCluster"`
+// GetName returns AppScaleQueryAppTaskGroupCountsTaskGroupCount.Name, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppTaskGroupCountsTaskGroupCount) GetName() string { return v.Name }
+
+// GetCount returns AppScaleQueryAppTaskGroupCountsTaskGroupCount.Count, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppTaskGroupCountsTaskGroupCount) GetCount() int { return v.Count }
+
+// AppScaleQueryAppVmSizeVMSize includes the requested fields of the GraphQL type VMSize.
+type AppScaleQueryAppVmSizeVMSize struct {
+	Name     string `json:"name"`
+	MemoryMb int    `json:"memoryMb"`
 }
 
-// GetCreatePostgresCluster returns CreatePostgresClusterResponse.CreatePostgresCluster, and is useful for accessing the field via an interface.
-func (v *CreatePostgresClusterResponse) GetCreatePostgresCluster() CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload {
-	return v.CreatePostgresCluster
+// GetName returns AppScaleQueryAppVmSizeVMSize.Name, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppVmSizeVMSize) GetName() string { return v.Name }
+
+// GetMemoryMb returns AppScaleQueryAppVmSizeVMSize.MemoryMb, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryAppVmSizeVMSize) GetMemoryMb() int { return v.MemoryMb }
+
+// AppScaleQueryResponse is returned by AppScaleQuery on success.
+type AppScaleQueryResponse struct {
+	// Find an app by name
+	App AppScaleQueryApp `json:"app"`
 }
 
-// CreateVolumeCreateVolumeCreateVolumePayload includes the requested fields of the GraphQL type CreateVolumePayload.
+// GetApp returns AppScaleQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *AppScaleQueryResponse) GetApp() AppScaleQueryApp { return v.App }
+
+// AttachPostgresClusterMutationAttachPostgresClusterAttachPostgresClusterPayload includes the requested fields of the GraphQL type AttachPostgresClusterPayload.
 // The GraphQL type's documentation follows.
 //
-// Autogenerated return type of CreateVolume
-type CreateVolumeCreateVolumeCreateVolumePayload struct {
-	Volume CreateVolumeCreateVolumeCreateVolumePayloadVolume `json:"volume"`
+// Autogenerated return type of AttachPostgresCluster
+type AttachPostgresClusterMutationAttachPostgresClusterAttachPostgresClusterPayload struct {
+	ConnectionString        string `json:"connectionString"`
+	EnvironmentVariableName string `json:"environmentVariableName"`
 }
 
-// GetVolume returns CreateVolumeCreateVolumeCreateVolumePayload.Volume, and is useful for accessing the field via an interface.
-func (v *CreateVolumeCreateVolumeCreateVolumePayload) GetVolume() CreateVolumeCreateVolumeCreateVolumePayloadVolume {
-	return v.Volume
+// GetConnectionString returns AttachPostgresClusterMutationAttachPostgresClusterAttachPostgresClusterPayload.ConnectionString, and is useful for accessing the field via an interface.
+func (v *AttachPostgresClusterMutationAttachPostgresClusterAttachPostgresClusterPayload) GetConnectionString() string {
+	return v.ConnectionString
 }
 
-// CreateVolumeCreateVolumeCreateVolumePayloadVolume includes the requested fields of the GraphQL type Volume.
-type CreateVolumeCreateVolumeCreateVolumePayloadVolume struct {
-	Name       string `json:"name"`
-	Region     string `json:"region"`
-	Id         string `json:"id"`
-	InternalId string `json:"internalId"`
-	SizeGb     int    `json:"sizeGb"`
+// GetEnvironmentVariableName returns AttachPostgresClusterMutationAttachPostgresClusterAttachPostgresClusterPayload.EnvironmentVariableName, and is useful for accessing the field via an interface.
+func (v *AttachPostgresClusterMutationAttachPostgresClusterAttachPostgresClusterPayload) GetEnvironmentVariableName() string {
+	return v.EnvironmentVariableName
 }
 
-// GetName returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.Name, and is useful for accessing the field via an interface.
-func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetName() string { return v.Name }
-
-// GetRegion returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.Region, and is useful for accessing the field via an interface.
-func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetRegion() string { return v.Region }
-
-// GetId returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.Id, and is useful for accessing the field via an interface.
-func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetId() string { return v.Id }
-
-// GetInternalId returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.InternalId, and is useful for accessing the field via an interface.
-func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetInternalId() string {
-	return v.InternalId
+// AttachPostgresClusterMutationResponse is returned by AttachPostgresClusterMutation on success.
+type AttachPostgresClusterMutationResponse struct {
+	AttachPostgresCluster AttachPostgresClusterMutationAttachPostgresClusterAttachPostgresClusterPayload `json:"attachPostgresCluster"`
 }
 
-// GetSizeGb returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.SizeGb, and is useful for accessing the field via an interface.
-func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetSizeGb() int { return v.SizeGb }
+// GetAttachPostgresCluster returns AttachPostgresClusterMutationResponse.AttachPostgresCluster, and is useful for accessing the field via an interface.
+func (v *AttachPostgresClusterMutationResponse) GetAttachPostgresCluster() AttachPostgresClusterMutationAttachPostgresClusterAttachPostgresClusterPayload {
+	return v.AttachPostgresCluster
+}
 
-// CreateVolumeResponse is returned by CreateVolume on success.
-type CreateVolumeResponse struct {
-	CreateVolume CreateVolumeCreateVolumeCreateVolumePayload `json:"createVolume"`
+// Region autoscaling configuration
+type AutoscaleRegionConfigInput struct {
+	// The region code to configure
+	Code string `json:"code"`
+	// The weight
+	Weight *int `json:"weight,omitempty"`
+	// Minimum number of VMs to run in this region
+	MinCount *int `json:"minCount,omitempty"`
+	// Reset the configuration for this region
+	Reset *bool `json:"reset,omitempty"`
 }
 
-// GetCreateVolume returns CreateVolumeResponse.CreateVolume, and is useful for accessing the field via an interface.
-func (v *CreateVolumeResponse) GetCreateVolume() CreateVolumeCreateVolumeCreateVolumePayload {
-	return v.CreateVolume
-}
+// GetCode returns AutoscaleRegionConfigInput.Code, and is useful for accessing the field via an interface.
+func (v *AutoscaleRegionConfigInput) GetCode() string { return v.Code }
 
-// DeleteAppMutationDeleteAppDeleteAppPayload includes the requested fields of the GraphQL type DeleteAppPayload.
-// The GraphQL type's documentation follows.
-//
-// Autogenerated return type of DeleteApp
-type DeleteAppMutationDeleteAppDeleteAppPayload struct {
-	// The organization that owned the deleted app
-	Organization DeleteAppMutationDeleteAppDeleteAppPayloadOrganization `json:"organization"`
-}
+// GetWeight returns AutoscaleRegionConfigInput.Weight, and is useful for accessing the field via an interface.
+func (v *AutoscaleRegionConfigInput) GetWeight() *int { return v.Weight }
 
-// GetOrganization returns DeleteAppMutationDeleteAppDeleteAppPayload.Organization, and is useful for accessing the field via an interface.
-func (v *DeleteAppMutationDeleteAppDeleteAppPayload) GetOrganization() DeleteAppMutationDeleteAppDeleteAppPayloadOrganization {
-	return v.Organization
-}
+// GetMinCount returns AutoscaleRegionConfigInput.MinCount, and is useful for accessing the field via an interface.
+func (v *AutoscaleRegionConfigInput) GetMinCount() *int { return v.MinCount }
 
-// DeleteAppMutationDeleteAppDeleteAppPayloadOrganization includes the requested fields of the GraphQL type Organization.
-type DeleteAppMutationDeleteAppDeleteAppPayloadOrganization struct {
-	// Organization name
-	Name string `json:"name"`
-}
+// GetReset returns AutoscaleRegionConfigInput.Reset, and is useful for accessing the field via an interface.
+func (v *AutoscaleRegionConfigInput) GetReset() *bool { return v.Reset }
 
-// GetName returns DeleteAppMutationDeleteAppDeleteAppPayloadOrganization.Name, and is useful for accessing the field via an interface.
-func (v *DeleteAppMutationDeleteAppDeleteAppPayloadOrganization) GetName() string { return v.Name }
+type AutoscaleStrategy string
 
-// DeleteAppMutationResponse is returned by DeleteAppMutation on success.
-type DeleteAppMutationResponse struct {
-	// Delete an app
-	DeleteApp DeleteAppMutationDeleteAppDeleteAppPayload `json:"deleteApp"`
-}
+const (
+	// autoscaling is disabled
+	AutoscaleStrategyNone AutoscaleStrategy = "NONE"
+	// place vms in preferred regions by weight
+	AutoscaleStrategyPreferredRegions AutoscaleStrategy = "PREFERRED_REGIONS"
+	// place vms in regions near connection sources
+	AutoscaleStrategyConnectionSources AutoscaleStrategy = "CONNECTION_SOURCES"
+)
 
-// GetDeleteApp returns DeleteAppMutationResponse.DeleteApp, and is useful for accessing the field via an interface.
-func (v *DeleteAppMutationResponse) GetDeleteApp() DeleteAppMutationDeleteAppDeleteAppPayload {
-	return v.DeleteApp
-}
+type BillingStatus string
 
-// DeleteCertificateDeleteCertificateDeleteCertificatePayload includes the requested fields of the GraphQL type DeleteCertificatePayload.
+const (
+	BillingStatusCurrent        BillingStatus = "CURRENT"
+	BillingStatusSourceRequired BillingStatus = "SOURCE_REQUIRED"
+	BillingStatusPastDue        BillingStatus = "PAST_DUE"
+)
+
+// All available http checks verbs
+type CheckHTTPVerb string
+
+const (
+	CheckHTTPVerbGet  CheckHTTPVerb = "GET"
+	CheckHTTPVerbHead CheckHTTPVerb = "HEAD"
+)
+
+// CheckJobFragment includes the GraphQL fields of CheckJob requested by the fragment CheckJobFragment.
 // The GraphQL type's documentation follows.
 //
-// Autogenerated return type of DeleteCertificate
-type DeleteCertificateDeleteCertificateDeleteCertificatePayload struct {
-	App         DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp                       `json:"app"`
-	Certificate DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate `json:"certificate"`
+// check job
+type CheckJobFragment struct {
+	Id          string                                           `json:"id"`
+	Url         string                                           `json:"url"`
+	Schedule    string                                           `json:"schedule"`
+	HttpOptions CheckJobFragmentHttpOptionsCheckJobHTTPOptions   `json:"httpOptions"`
+	Locations   CheckJobFragmentLocationsCheckLocationConnection `json:"locations"`
 }
 
-// GetApp returns DeleteCertificateDeleteCertificateDeleteCertificatePayload.App, and is useful for accessing the field via an interface.
-func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayload) GetApp() DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp {
-	return v.App
-}
+// GetId returns CheckJobFragment.Id, and is useful for accessing the field via an interface.
+func (v *CheckJobFragment) GetId() string { return v.Id }
 
-// GetCertificate returns DeleteCertificateDeleteCertificateDeleteCertificatePayload.Certificate, and is useful for accessing the field via an interface.
-func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayload) GetCertificate() DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate {
-	return v.Certificate
-}
+// GetUrl returns CheckJobFragment.Url, and is useful for accessing the field via an interface.
+func (v *CheckJobFragment) GetUrl() string { return v.Url }
 
-// DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp includes the requested fields of the GraphQL type App.
-type DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp struct {
-	// The unique application name
-	Name string `json:"name"`
-}
+// GetSchedule returns CheckJobFragment.Schedule, and is useful for accessing the field via an interface.
+func (v *CheckJobFragment) GetSchedule() string { return v.Schedule }
 
-// GetName returns DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp.Name, and is useful for accessing the field via an interface.
-func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp) GetName() string {
-	return v.Name
+// GetHttpOptions returns CheckJobFragment.HttpOptions, and is useful for accessing the field via an interface.
+func (v *CheckJobFragment) GetHttpOptions() CheckJobFragmentHttpOptionsCheckJobHTTPOptions {
+	return v.HttpOptions
 }
 
-// DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate includes the requested fields of the GraphQL type AppCertificate.
-type DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate struct {
-	Hostname string `json:"hostname"`
-	Id       string `json:"id"`
+// GetLocations returns CheckJobFragment.Locations, and is useful for accessing the field via an interface.
+func (v *CheckJobFragment) GetLocations() CheckJobFragmentLocationsCheckLocationConnection {
+	return v.Locations
 }
 
-// GetHostname returns DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate.Hostname, and is useful for accessing the field via an interface.
-func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate) GetHostname() string {
-	return v.Hostname
+// CheckJobFragmentHttpOptionsCheckJobHTTPOptions includes the requested fields of the GraphQL type CheckJobHTTPOptions.
+// The GraphQL type's documentation follows.
+//
+// health check state
+type CheckJobFragmentHttpOptionsCheckJobHTTPOptions struct {
+	Verb    CheckHTTPVerb `json:"verb"`
+	Headers []string      `json:"headers"`
 }
 
-// GetId returns DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate.Id, and is useful for accessing the field via an interface.
-func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate) GetId() string {
-	return v.Id
-}
+// GetVerb returns CheckJobFragmentHttpOptionsCheckJobHTTPOptions.Verb, and is useful for accessing the field via an interface.
+func (v *CheckJobFragmentHttpOptionsCheckJobHTTPOptions) GetVerb() CheckHTTPVerb { return v.Verb }
 
-// DeleteCertificateResponse is returned by DeleteCertificate on success.
-type DeleteCertificateResponse struct {
-	DeleteCertificate DeleteCertificateDeleteCertificateDeleteCertificatePayload `json:"deleteCertificate"`
+// GetHeaders returns CheckJobFragmentHttpOptionsCheckJobHTTPOptions.Headers, and is useful for accessing the field via an interface.
+func (v *CheckJobFragmentHttpOptionsCheckJobHTTPOptions) GetHeaders() []string { return v.Headers }
+
+// CheckJobFragmentLocationsCheckLocationConnection includes the requested fields of the GraphQL type CheckLocationConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for CheckLocation.
+type CheckJobFragmentLocationsCheckLocationConnection struct {
+	// A list of nodes.
+	Nodes []CheckJobFragmentLocationsCheckLocationConnectionNodesCheckLocation `json:"nodes"`
 }
 
-// GetDeleteCertificate returns DeleteCertificateResponse.DeleteCertificate, and is useful for accessing the field via an interface.
-func (v *DeleteCertificateResponse) GetDeleteCertificate() DeleteCertificateDeleteCertificateDeleteCertificatePayload {
-	return v.DeleteCertificate
+// GetNodes returns CheckJobFragmentLocationsCheckLocationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *CheckJobFragmentLocationsCheckLocationConnection) GetNodes() []CheckJobFragmentLocationsCheckLocationConnectionNodesCheckLocation {
+	return v.Nodes
 }
 
-// DeleteVolumeDeleteVolumeDeleteVolumePayload includes the requested fields of the GraphQL type DeleteVolumePayload.
+// CheckJobFragmentLocationsCheckLocationConnectionNodesCheckLocation includes the requested fields of the GraphQL type CheckLocation.
 // The GraphQL type's documentation follows.
 //
-// Autogenerated return type of DeleteVolume
-type DeleteVolumeDeleteVolumeDeleteVolumePayload struct {
-	// A unique identifier for the client performing the mutation.
-	ClientMutationId string `json:"clientMutationId"`
+// check location
+type CheckJobFragmentLocationsCheckLocationConnectionNodesCheckLocation struct {
+	Name string `json:"name"`
 }
 
-// GetClientMutationId returns DeleteVolumeDeleteVolumeDeleteVolumePayload.ClientMutationId, and is useful for accessing the field via an interface.
-func (v *DeleteVolumeDeleteVolumeDeleteVolumePayload) GetClientMutationId() string {
-	return v.ClientMutationId
+// GetName returns CheckJobFragmentLocationsCheckLocationConnectionNodesCheckLocation.Name, and is useful for accessing the field via an interface.
+func (v *CheckJobFragmentLocationsCheckLocationConnectionNodesCheckLocation) GetName() string {
+	return v.Name
 }
 
-// DeleteVolumeResponse is returned by DeleteVolume on success.
-type DeleteVolumeResponse struct {
-	DeleteVolume DeleteVolumeDeleteVolumeDeleteVolumePayload `json:"deleteVolume"`
+// CheckJobQueryNode includes the requested fields of the GraphQL interface Node.
+//
+// CheckJobQueryNode is implemented by the following types:
+// CheckJobQueryNodeAccessToken
+// CheckJobQueryNodeAllocation
+// CheckJobQueryNodeApp
+// CheckJobQueryNodeAppCertificate
+// CheckJobQueryNodeAppChange
+// CheckJobQueryNodeBuild
+// CheckJobQueryNodeCertificate
+// CheckJobQueryNodeCheckHTTPResponse
+// CheckJobQueryNodeCheckJob
+// CheckJobQueryNodeCheckJobRun
+// CheckJobQueryNodeDelegatedWireGuardToken
+// CheckJobQueryNodeDNSPortal
+// CheckJobQueryNodeDNSPortalSession
+// CheckJobQueryNodeDNSRecord
+// CheckJobQueryNodeDomain
+// CheckJobQueryNodeHost
+// CheckJobQueryNodeIPAddress
+// CheckJobQueryNodeLoggedCertificate
+// CheckJobQueryNodeMachine
+// CheckJobQueryNodeMachineIP
+// CheckJobQueryNodeOrganization
+// CheckJobQueryNodeOrganizationInvitation
+// CheckJobQueryNodePostgresClusterAttachment
+// CheckJobQueryNodeRelease
+// CheckJobQueryNodeReleaseCommand
+// CheckJobQueryNodeSecret
+// CheckJobQueryNodeSourceBuild
+// CheckJobQueryNodeTemplateDeployment
+// CheckJobQueryNodeUser
+// CheckJobQueryNodeVM
+// CheckJobQueryNodeVolume
+// CheckJobQueryNodeVolumeSnapshot
+// CheckJobQueryNodeWireGuardPeer
+// The GraphQL type's documentation follows.
+//
+// An object with an ID.
+type CheckJobQueryNode interface {
+	implementsGraphQLInterfaceCheckJobQueryNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
 }
 
-// GetDeleteVolume returns DeleteVolumeResponse.DeleteVolume, and is useful for accessing the field via an interface.
-func (v *DeleteVolumeResponse) GetDeleteVolume() DeleteVolumeDeleteVolumeDeleteVolumePayload {
-	return v.DeleteVolume
-}
+func (v *CheckJobQueryNodeAccessToken) implementsGraphQLInterfaceCheckJobQueryNode()               {}
+func (v *CheckJobQueryNodeAllocation) implementsGraphQLInterfaceCheckJobQueryNode()                {}
+func (v *CheckJobQueryNodeApp) implementsGraphQLInterfaceCheckJobQueryNode()                       {}
+func (v *CheckJobQueryNodeAppCertificate) implementsGraphQLInterfaceCheckJobQueryNode()            {}
+func (v *CheckJobQueryNodeAppChange) implementsGraphQLInterfaceCheckJobQueryNode()                 {}
+func (v *CheckJobQueryNodeBuild) implementsGraphQLInterfaceCheckJobQueryNode()                     {}
+func (v *CheckJobQueryNodeCertificate) implementsGraphQLInterfaceCheckJobQueryNode()               {}
+func (v *CheckJobQueryNodeCheckHTTPResponse) implementsGraphQLInterfaceCheckJobQueryNode()         {}
+func (v *CheckJobQueryNodeCheckJob) implementsGraphQLInterfaceCheckJobQueryNode()                  {}
+func (v *CheckJobQueryNodeCheckJobRun) implementsGraphQLInterfaceCheckJobQueryNode()               {}
+func (v *CheckJobQueryNodeDelegatedWireGuardToken) implementsGraphQLInterfaceCheckJobQueryNode()   {}
+func (v *CheckJobQueryNodeDNSPortal) implementsGraphQLInterfaceCheckJobQueryNode()                 {}
+func (v *CheckJobQueryNodeDNSPortalSession) implementsGraphQLInterfaceCheckJobQueryNode()          {}
+func (v *CheckJobQueryNodeDNSRecord) implementsGraphQLInterfaceCheckJobQueryNode()                 {}
+func (v *CheckJobQueryNodeDomain) implementsGraphQLInterfaceCheckJobQueryNode()                    {}
+func (v *CheckJobQueryNodeHost) implementsGraphQLInterfaceCheckJobQueryNode()                      {}
+func (v *CheckJobQueryNodeIPAddress) implementsGraphQLInterfaceCheckJobQueryNode()                 {}
+func (v *CheckJobQueryNodeLoggedCertificate) implementsGraphQLInterfaceCheckJobQueryNode()         {}
+func (v *CheckJobQueryNodeMachine) implementsGraphQLInterfaceCheckJobQueryNode()                   {}
+func (v *CheckJobQueryNodeMachineIP) implementsGraphQLInterfaceCheckJobQueryNode()                 {}
+func (v *CheckJobQueryNodeOrganization) implementsGraphQLInterfaceCheckJobQueryNode()              {}
+func (v *CheckJobQueryNodeOrganizationInvitation) implementsGraphQLInterfaceCheckJobQueryNode()    {}
+func (v *CheckJobQueryNodePostgresClusterAttachment) implementsGraphQLInterfaceCheckJobQueryNode() {}
+func (v *CheckJobQueryNodeRelease) implementsGraphQLInterfaceCheckJobQueryNode()                   {}
+func (v *CheckJobQueryNodeReleaseCommand) implementsGraphQLInterfaceCheckJobQueryNode()            {}
+func (v *CheckJobQueryNodeSecret) implementsGraphQLInterfaceCheckJobQueryNode()                    {}
+func (v *CheckJobQueryNodeSourceBuild) implementsGraphQLInterfaceCheckJobQueryNode()               {}
+func (v *CheckJobQueryNodeTemplateDeployment) implementsGraphQLInterfaceCheckJobQueryNode()        {}
+func (v *CheckJobQueryNodeUser) implementsGraphQLInterfaceCheckJobQueryNode()                      {}
+func (v *CheckJobQueryNodeVM) implementsGraphQLInterfaceCheckJobQueryNode()                        {}
+func (v *CheckJobQueryNodeVolume) implementsGraphQLInterfaceCheckJobQueryNode()                    {}
+func (v *CheckJobQueryNodeVolumeSnapshot) implementsGraphQLInterfaceCheckJobQueryNode()            {}
+func (v *CheckJobQueryNodeWireGuardPeer) implementsGraphQLInterfaceCheckJobQueryNode()             {}
+
+func __unmarshalCheckJobQueryNode(b []byte, v *CheckJobQueryNode) error {
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetCertificateApp includes the requested fields of the GraphQL type App.
-type GetCertificateApp struct {
-	// Find a certificate by hostname
-	Certificate GetCertificateAppCertificate `json:"certificate"`
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AccessToken":
+		*v = new(CheckJobQueryNodeAccessToken)
+		return json.Unmarshal(b, *v)
+	case "Allocation":
+		*v = new(CheckJobQueryNodeAllocation)
+		return json.Unmarshal(b, *v)
+	case "App":
+		*v = new(CheckJobQueryNodeApp)
+		return json.Unmarshal(b, *v)
+	case "AppCertificate":
+		*v = new(CheckJobQueryNodeAppCertificate)
+		return json.Unmarshal(b, *v)
+	case "AppChange":
+		*v = new(CheckJobQueryNodeAppChange)
+		return json.Unmarshal(b, *v)
+	case "Build":
+		*v = new(CheckJobQueryNodeBuild)
+		return json.Unmarshal(b, *v)
+	case "Certificate":
+		*v = new(CheckJobQueryNodeCertificate)
+		return json.Unmarshal(b, *v)
+	case "CheckHTTPResponse":
+		*v = new(CheckJobQueryNodeCheckHTTPResponse)
+		return json.Unmarshal(b, *v)
+	case "CheckJob":
+		*v = new(CheckJobQueryNodeCheckJob)
+		return json.Unmarshal(b, *v)
+	case "CheckJobRun":
+		*v = new(CheckJobQueryNodeCheckJobRun)
+		return json.Unmarshal(b, *v)
+	case "DelegatedWireGuardToken":
+		*v = new(CheckJobQueryNodeDelegatedWireGuardToken)
+		return json.Unmarshal(b, *v)
+	case "DNSPortal":
+		*v = new(CheckJobQueryNodeDNSPortal)
+		return json.Unmarshal(b, *v)
+	case "DNSPortalSession":
+		*v = new(CheckJobQueryNodeDNSPortalSession)
+		return json.Unmarshal(b, *v)
+	case "DNSRecord":
+		*v = new(CheckJobQueryNodeDNSRecord)
+		return json.Unmarshal(b, *v)
+	case "Domain":
+		*v = new(CheckJobQueryNodeDomain)
+		return json.Unmarshal(b, *v)
+	case "Host":
+		*v = new(CheckJobQueryNodeHost)
+		return json.Unmarshal(b, *v)
+	case "IPAddress":
+		*v = new(CheckJobQueryNodeIPAddress)
+		return json.Unmarshal(b, *v)
+	case "LoggedCertificate":
+		*v = new(CheckJobQueryNodeLoggedCertificate)
+		return json.Unmarshal(b, *v)
+	case "Machine":
+		*v = new(CheckJobQueryNodeMachine)
+		return json.Unmarshal(b, *v)
+	case "MachineIP":
+		*v = new(CheckJobQueryNodeMachineIP)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(CheckJobQueryNodeOrganization)
+		return json.Unmarshal(b, *v)
+	case "OrganizationInvitation":
+		*v = new(CheckJobQueryNodeOrganizationInvitation)
+		return json.Unmarshal(b, *v)
+	case "PostgresClusterAttachment":
+		*v = new(CheckJobQueryNodePostgresClusterAttachment)
+		return json.Unmarshal(b, *v)
+	case "Release":
+		*v = new(CheckJobQueryNodeRelease)
+		return json.Unmarshal(b, *v)
+	case "ReleaseCommand":
+		*v = new(CheckJobQueryNodeReleaseCommand)
+		return json.Unmarshal(b, *v)
+	case "Secret":
+		*v = new(CheckJobQueryNodeSecret)
+		return json.Unmarshal(b, *v)
+	case "SourceBuild":
+		*v = new(CheckJobQueryNodeSourceBuild)
+		return json.Unmarshal(b, *v)
+	case "TemplateDeployment":
+		*v = new(CheckJobQueryNodeTemplateDeployment)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(CheckJobQueryNodeUser)
+		return json.Unmarshal(b, *v)
+	case "VM":
+		*v = new(CheckJobQueryNodeVM)
+		return json.Unmarshal(b, *v)
+	case "Volume":
+		*v = new(CheckJobQueryNodeVolume)
+		return json.Unmarshal(b, *v)
+	case "VolumeSnapshot":
+		*v = new(CheckJobQueryNodeVolumeSnapshot)
+		return json.Unmarshal(b, *v)
+	case "WireGuardPeer":
+		*v = new(CheckJobQueryNodeWireGuardPeer)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Node.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for CheckJobQueryNode: "%v"`, tn.TypeName)
+	}
 }
 
-// GetCertificate returns GetCertificateApp.Certificate, and is useful for accessing the field via an interface.
-func (v *GetCertificateApp) GetCertificate() GetCertificateAppCertificate { return v.Certificate }
+func __marshalCheckJobQueryNode(v *CheckJobQueryNode) ([]byte, error) {
 
-// GetCertificateAppCertificate includes the requested fields of the GraphQL type AppCertificate.
-type GetCertificateAppCertificate struct {
-	Id                        string `json:"id"`
-	DnsValidationInstructions string `json:"dnsValidationInstructions"`
-	DnsValidationHostname     string `json:"dnsValidationHostname"`
-	DnsValidationTarget       string `json:"dnsValidationTarget"`
-	Hostname                  string `json:"hostname"`
-	Check                     bool   `json:"check"`
-}
+	var typename string
+	switch v := (*v).(type) {
+	case *CheckJobQueryNodeAccessToken:
+		typename = "AccessToken"
 
-// GetId returns GetCertificateAppCertificate.Id, and is useful for accessing the field via an interface.
-func (v *GetCertificateAppCertificate) GetId() string { return v.Id }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeAccessToken
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeAllocation:
+		typename = "Allocation"
 
-// GetDnsValidationInstructions returns GetCertificateAppCertificate.DnsValidationInstructions, and is useful for accessing the field via an interface.
-func (v *GetCertificateAppCertificate) GetDnsValidationInstructions() string {
-	return v.DnsValidationInstructions
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeAllocation
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeApp:
+		typename = "App"
 
-// GetDnsValidationHostname returns GetCertificateAppCertificate.DnsValidationHostname, and is useful for accessing the field via an interface.
-func (v *GetCertificateAppCertificate) GetDnsValidationHostname() string {
-	return v.DnsValidationHostname
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeAppCertificate:
+		typename = "AppCertificate"
 
-// GetDnsValidationTarget returns GetCertificateAppCertificate.DnsValidationTarget, and is useful for accessing the field via an interface.
-func (v *GetCertificateAppCertificate) GetDnsValidationTarget() string { return v.DnsValidationTarget }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeAppCertificate
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeAppChange:
+		typename = "AppChange"
 
-// GetHostname returns GetCertificateAppCertificate.Hostname, and is useful for accessing the field via an interface.
-func (v *GetCertificateAppCertificate) GetHostname() string { return v.Hostname }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeAppChange
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeBuild:
+		typename = "Build"
 
-// GetCheck returns GetCertificateAppCertificate.Check, and is useful for accessing the field via an interface.
-func (v *GetCertificateAppCertificate) GetCheck() bool { return v.Check }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeBuild
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeCertificate:
+		typename = "Certificate"
 
-// GetCertificateResponse is returned by GetCertificate on success.
-type GetCertificateResponse struct {
-	// Find an app by name
-	App GetCertificateApp `json:"app"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeCertificate
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeCheckHTTPResponse:
+		typename = "CheckHTTPResponse"
 
-// GetApp returns GetCertificateResponse.App, and is useful for accessing the field via an interface.
-func (v *GetCertificateResponse) GetApp() GetCertificateApp { return v.App }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeCheckHTTPResponse
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeCheckJob:
+		typename = "CheckJob"
 
-// GetFullAppApp includes the requested fields of the GraphQL type App.
-type GetFullAppApp struct {
-	// The unique application name
-	Name    string `json:"name"`
-	Network string `json:"network"`
-	// Organization that owns this app
-	Organization GetFullAppAppOrganization                 `json:"organization"`
-	Autoscaling  GetFullAppAppAutoscalingAutoscalingConfig `json:"autoscaling"`
-	AppUrl       string                                    `json:"appUrl"`
-	// Autogenerated hostname for this application
-	Hostname string `json:"hostname"`
-	// Unique application ID
-	Id string `json:"id"`
-	// Application status
-	Status   string `json:"status"`
-	Deployed bool   `json:"deployed"`
-	// The latest release of this applicaion
-	CurrentRelease GetFullAppAppCurrentRelease                   `json:"currentRelease"`
-	Config         GetFullAppAppConfig                           `json:"config"`
-	HealthChecks   GetFullAppAppHealthChecksCheckStateConnection `json:"healthChecks"`
-	IpAddresses    GetFullAppAppIpAddressesIPAddressConnection   `json:"ipAddresses"`
-	Role           GetFullAppAppRole                             `json:"-"`
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalCheckJobQueryNodeCheckJob
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeCheckJobRun:
+		typename = "CheckJobRun"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeCheckJobRun
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeDelegatedWireGuardToken:
+		typename = "DelegatedWireGuardToken"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeDelegatedWireGuardToken
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeDNSPortal:
+		typename = "DNSPortal"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeDNSPortal
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeDNSPortalSession:
+		typename = "DNSPortalSession"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeDNSPortalSession
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeDNSRecord:
+		typename = "DNSRecord"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeDNSRecord
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeDomain:
+		typename = "Domain"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeDomain
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeHost:
+		typename = "Host"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeHost
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeIPAddress:
+		typename = "IPAddress"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeIPAddress
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeLoggedCertificate:
+		typename = "LoggedCertificate"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeLoggedCertificate
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeMachine:
+		typename = "Machine"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeMachine
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeMachineIP:
+		typename = "MachineIP"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeMachineIP
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeOrganizationInvitation:
+		typename = "OrganizationInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeOrganizationInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodePostgresClusterAttachment:
+		typename = "PostgresClusterAttachment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodePostgresClusterAttachment
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeRelease:
+		typename = "Release"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeRelease
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeReleaseCommand:
+		typename = "ReleaseCommand"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeReleaseCommand
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeSecret:
+		typename = "Secret"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeSecret
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeSourceBuild:
+		typename = "SourceBuild"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeSourceBuild
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeTemplateDeployment:
+		typename = "TemplateDeployment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeTemplateDeployment
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeVM:
+		typename = "VM"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeVM
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeVolume:
+		typename = "Volume"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeVolume
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeVolumeSnapshot:
+		typename = "VolumeSnapshot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeVolumeSnapshot
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobQueryNodeWireGuardPeer:
+		typename = "WireGuardPeer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobQueryNodeWireGuardPeer
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for CheckJobQueryNode: "%T"`, v)
+	}
 }
 
-// GetName returns GetFullAppApp.Name, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetName() string { return v.Name }
+// CheckJobQueryNodeAccessToken includes the requested fields of the GraphQL type AccessToken.
+type CheckJobQueryNodeAccessToken struct {
+	Typename string `json:"__typename"`
+}
 
-// GetNetwork returns GetFullAppApp.Network, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetNetwork() string { return v.Network }
+// GetTypename returns CheckJobQueryNodeAccessToken.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeAccessToken) GetTypename() string { return v.Typename }
 
-// GetOrganization returns GetFullAppApp.Organization, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetOrganization() GetFullAppAppOrganization { return v.Organization }
+// CheckJobQueryNodeAllocation includes the requested fields of the GraphQL type Allocation.
+type CheckJobQueryNodeAllocation struct {
+	Typename string `json:"__typename"`
+}
 
-// GetAutoscaling returns GetFullAppApp.Autoscaling, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetAutoscaling() GetFullAppAppAutoscalingAutoscalingConfig {
-	return v.Autoscaling
+// GetTypename returns CheckJobQueryNodeAllocation.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeAllocation) GetTypename() string { return v.Typename }
+
+// CheckJobQueryNodeApp includes the requested fields of the GraphQL type App.
+type CheckJobQueryNodeApp struct {
+	Typename string `json:"__typename"`
 }
 
-// GetAppUrl returns GetFullAppApp.AppUrl, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetAppUrl() string { return v.AppUrl }
+// GetTypename returns CheckJobQueryNodeApp.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeApp) GetTypename() string { return v.Typename }
 
-// GetHostname returns GetFullAppApp.Hostname, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetHostname() string { return v.Hostname }
+// CheckJobQueryNodeAppCertificate includes the requested fields of the GraphQL type AppCertificate.
+type CheckJobQueryNodeAppCertificate struct {
+	Typename string `json:"__typename"`
+}
 
-// GetId returns GetFullAppApp.Id, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetId() string { return v.Id }
+// GetTypename returns CheckJobQueryNodeAppCertificate.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeAppCertificate) GetTypename() string { return v.Typename }
 
-// GetStatus returns GetFullAppApp.Status, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetStatus() string { return v.Status }
+// CheckJobQueryNodeAppChange includes the requested fields of the GraphQL type AppChange.
+type CheckJobQueryNodeAppChange struct {
+	Typename string `json:"__typename"`
+}
 
-// GetDeployed returns GetFullAppApp.Deployed, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetDeployed() bool { return v.Deployed }
+// GetTypename returns CheckJobQueryNodeAppChange.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeAppChange) GetTypename() string { return v.Typename }
 
-// GetCurrentRelease returns GetFullAppApp.CurrentRelease, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetCurrentRelease() GetFullAppAppCurrentRelease { return v.CurrentRelease }
+// CheckJobQueryNodeBuild includes the requested fields of the GraphQL type Build.
+type CheckJobQueryNodeBuild struct {
+	Typename string `json:"__typename"`
+}
 
-// GetConfig returns GetFullAppApp.Config, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetConfig() GetFullAppAppConfig { return v.Config }
+// GetTypename returns CheckJobQueryNodeBuild.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeBuild) GetTypename() string { return v.Typename }
 
-// GetHealthChecks returns GetFullAppApp.HealthChecks, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetHealthChecks() GetFullAppAppHealthChecksCheckStateConnection {
-	return v.HealthChecks
+// CheckJobQueryNodeCertificate includes the requested fields of the GraphQL type Certificate.
+type CheckJobQueryNodeCertificate struct {
+	Typename string `json:"__typename"`
 }
 
-// GetIpAddresses returns GetFullAppApp.IpAddresses, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetIpAddresses() GetFullAppAppIpAddressesIPAddressConnection {
-	return v.IpAddresses
+// GetTypename returns CheckJobQueryNodeCertificate.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeCertificate) GetTypename() string { return v.Typename }
+
+// CheckJobQueryNodeCheckHTTPResponse includes the requested fields of the GraphQL type CheckHTTPResponse.
+// The GraphQL type's documentation follows.
+//
+// check job http response
+type CheckJobQueryNodeCheckHTTPResponse struct {
+	Typename string `json:"__typename"`
 }
 
-// GetRole returns GetFullAppApp.Role, and is useful for accessing the field via an interface.
-func (v *GetFullAppApp) GetRole() GetFullAppAppRole { return v.Role }
+// GetTypename returns CheckJobQueryNodeCheckHTTPResponse.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeCheckHTTPResponse) GetTypename() string { return v.Typename }
 
-func (v *GetFullAppApp) UnmarshalJSON(b []byte) error {
+// CheckJobQueryNodeCheckJob includes the requested fields of the GraphQL type CheckJob.
+// The GraphQL type's documentation follows.
+//
+// check job
+type CheckJobQueryNodeCheckJob struct {
+	Typename         string `json:"__typename"`
+	CheckJobFragment `json:"-"`
+}
+
+// GetTypename returns CheckJobQueryNodeCheckJob.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeCheckJob) GetTypename() string { return v.Typename }
+
+// GetId returns CheckJobQueryNodeCheckJob.Id, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeCheckJob) GetId() string { return v.CheckJobFragment.Id }
+
+// GetUrl returns CheckJobQueryNodeCheckJob.Url, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeCheckJob) GetUrl() string { return v.CheckJobFragment.Url }
+
+// GetSchedule returns CheckJobQueryNodeCheckJob.Schedule, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeCheckJob) GetSchedule() string { return v.CheckJobFragment.Schedule }
+
+// GetHttpOptions returns CheckJobQueryNodeCheckJob.HttpOptions, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeCheckJob) GetHttpOptions() CheckJobFragmentHttpOptionsCheckJobHTTPOptions {
+	return v.CheckJobFragment.HttpOptions
+}
+
+// GetLocations returns CheckJobQueryNodeCheckJob.Locations, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeCheckJob) GetLocations() CheckJobFragmentLocationsCheckLocationConnection {
+	return v.CheckJobFragment.Locations
+}
+
+func (v *CheckJobQueryNodeCheckJob) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*GetFullAppApp
-		Role json.RawMessage `json:"role"`
+		*CheckJobQueryNodeCheckJob
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.GetFullAppApp = v
+	firstPass.CheckJobQueryNodeCheckJob = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	{
-		dst := &v.Role
-		src := firstPass.Role
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalGetFullAppAppRole(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"Unable to unmarshal GetFullAppApp.Role: %w", err)
-			}
-		}
+	err = json.Unmarshal(
+		b, &v.CheckJobFragment)
+	if err != nil {
+		return err
 	}
 	return nil
 }
 
-type __premarshalGetFullAppApp struct {
-	Name string `json:"name"`
-
-	Network string `json:"network"`
-
-	Organization GetFullAppAppOrganization `json:"organization"`
-
-	Autoscaling GetFullAppAppAutoscalingAutoscalingConfig `json:"autoscaling"`
-
-	AppUrl string `json:"appUrl"`
-
-	Hostname string `json:"hostname"`
+type __premarshalCheckJobQueryNodeCheckJob struct {
+	Typename string `json:"__typename"`
 
 	Id string `json:"id"`
 
-	Status string `json:"status"`
-
-	Deployed bool `json:"deployed"`
-
-	CurrentRelease GetFullAppAppCurrentRelease `json:"currentRelease"`
-
-	Config GetFullAppAppConfig `json:"config"`
+	Url string `json:"url"`
 
-	HealthChecks GetFullAppAppHealthChecksCheckStateConnection `json:"healthChecks"`
+	Schedule string `json:"schedule"`
 
-	IpAddresses GetFullAppAppIpAddressesIPAddressConnection `json:"ipAddresses"`
+	HttpOptions CheckJobFragmentHttpOptionsCheckJobHTTPOptions `json:"httpOptions"`
 
-	Role json.RawMessage `json:"role"`
+	Locations CheckJobFragmentLocationsCheckLocationConnection `json:"locations"`
 }
 
-func (v *GetFullAppApp) MarshalJSON() ([]byte, error) {
+func (v *CheckJobQueryNodeCheckJob) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -835,868 +1264,8262 @@ func (v *GetFullAppApp) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *GetFullAppApp) __premarshalJSON() (*__premarshalGetFullAppApp, error) {
-	var retval __premarshalGetFullAppApp
+func (v *CheckJobQueryNodeCheckJob) __premarshalJSON() (*__premarshalCheckJobQueryNodeCheckJob, error) {
+	var retval __premarshalCheckJobQueryNodeCheckJob
 
-	retval.Name = v.Name
-	retval.Network = v.Network
-	retval.Organization = v.Organization
-	retval.Autoscaling = v.Autoscaling
-	retval.AppUrl = v.AppUrl
-	retval.Hostname = v.Hostname
-	retval.Id = v.Id
-	retval.Status = v.Status
-	retval.Deployed = v.Deployed
-	retval.CurrentRelease = v.CurrentRelease
-	retval.Config = v.Config
-	retval.HealthChecks = v.HealthChecks
-	retval.IpAddresses = v.IpAddresses
-	{
-
-		dst := &retval.Role
-		src := v.Role
-		var err error
-		*dst, err = __marshalGetFullAppAppRole(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"Unable to marshal GetFullAppApp.Role: %w", err)
-		}
-	}
+	retval.Typename = v.Typename
+	retval.Id = v.CheckJobFragment.Id
+	retval.Url = v.CheckJobFragment.Url
+	retval.Schedule = v.CheckJobFragment.Schedule
+	retval.HttpOptions = v.CheckJobFragment.HttpOptions
+	retval.Locations = v.CheckJobFragment.Locations
 	return &retval, nil
 }
 
-// GetFullAppAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
-type GetFullAppAppAutoscalingAutoscalingConfig struct {
-	PreferredRegion string                                                                  `json:"preferredRegion"`
-	Regions         []GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig `json:"regions"`
+// CheckJobQueryNodeCheckJobRun includes the requested fields of the GraphQL type CheckJobRun.
+// The GraphQL type's documentation follows.
+//
+// check job run
+type CheckJobQueryNodeCheckJobRun struct {
+	Typename string `json:"__typename"`
 }
 
-// GetPreferredRegion returns GetFullAppAppAutoscalingAutoscalingConfig.PreferredRegion, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppAutoscalingAutoscalingConfig) GetPreferredRegion() string {
-	return v.PreferredRegion
-}
+// GetTypename returns CheckJobQueryNodeCheckJobRun.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeCheckJobRun) GetTypename() string { return v.Typename }
 
-// GetRegions returns GetFullAppAppAutoscalingAutoscalingConfig.Regions, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppAutoscalingAutoscalingConfig) GetRegions() []GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig {
-	return v.Regions
+// CheckJobQueryNodeDNSPortal includes the requested fields of the GraphQL type DNSPortal.
+type CheckJobQueryNodeDNSPortal struct {
+	Typename string `json:"__typename"`
 }
 
-// GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig includes the requested fields of the GraphQL type AutoscaleRegionConfig.
-type GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig struct {
-	// The region code
-	Code string `json:"code"`
-}
+// GetTypename returns CheckJobQueryNodeDNSPortal.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeDNSPortal) GetTypename() string { return v.Typename }
 
-// GetCode returns GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig.Code, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) GetCode() string {
-	return v.Code
+// CheckJobQueryNodeDNSPortalSession includes the requested fields of the GraphQL type DNSPortalSession.
+type CheckJobQueryNodeDNSPortalSession struct {
+	Typename string `json:"__typename"`
 }
 
-// GetFullAppAppConfig includes the requested fields of the GraphQL type AppConfig.
-type GetFullAppAppConfig struct {
-	Definition interface{} `json:"definition"`
+// GetTypename returns CheckJobQueryNodeDNSPortalSession.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeDNSPortalSession) GetTypename() string { return v.Typename }
+
+// CheckJobQueryNodeDNSRecord includes the requested fields of the GraphQL type DNSRecord.
+type CheckJobQueryNodeDNSRecord struct {
+	Typename string `json:"__typename"`
 }
 
-// GetDefinition returns GetFullAppAppConfig.Definition, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppConfig) GetDefinition() interface{} { return v.Definition }
+// GetTypename returns CheckJobQueryNodeDNSRecord.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeDNSRecord) GetTypename() string { return v.Typename }
 
-// GetFullAppAppCurrentRelease includes the requested fields of the GraphQL type Release.
-type GetFullAppAppCurrentRelease struct {
-	// Unique ID
-	Id string `json:"id"`
+// CheckJobQueryNodeDelegatedWireGuardToken includes the requested fields of the GraphQL type DelegatedWireGuardToken.
+type CheckJobQueryNodeDelegatedWireGuardToken struct {
+	Typename string `json:"__typename"`
 }
 
-// GetId returns GetFullAppAppCurrentRelease.Id, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppCurrentRelease) GetId() string { return v.Id }
+// GetTypename returns CheckJobQueryNodeDelegatedWireGuardToken.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeDelegatedWireGuardToken) GetTypename() string { return v.Typename }
 
-// GetFullAppAppHealthChecksCheckStateConnection includes the requested fields of the GraphQL type CheckStateConnection.
-// The GraphQL type's documentation follows.
-//
-// The connection type for CheckState.
-type GetFullAppAppHealthChecksCheckStateConnection struct {
-	// A list of nodes.
-	Nodes []GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState `json:"nodes"`
+// CheckJobQueryNodeDomain includes the requested fields of the GraphQL type Domain.
+type CheckJobQueryNodeDomain struct {
+	Typename string `json:"__typename"`
 }
 
-// GetNodes returns GetFullAppAppHealthChecksCheckStateConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppHealthChecksCheckStateConnection) GetNodes() []GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState {
-	return v.Nodes
-}
+// GetTypename returns CheckJobQueryNodeDomain.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeDomain) GetTypename() string { return v.Typename }
 
-// GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState includes the requested fields of the GraphQL type CheckState.
-// The GraphQL type's documentation follows.
-//
-// health check state
-type GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState struct {
-	Name   string `json:"name"`
-	Status string `json:"status"`
+// CheckJobQueryNodeHost includes the requested fields of the GraphQL type Host.
+type CheckJobQueryNodeHost struct {
+	Typename string `json:"__typename"`
 }
 
-// GetName returns GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState.Name, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState) GetName() string {
-	return v.Name
-}
+// GetTypename returns CheckJobQueryNodeHost.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeHost) GetTypename() string { return v.Typename }
 
-// GetStatus returns GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState.Status, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState) GetStatus() string {
-	return v.Status
+// CheckJobQueryNodeIPAddress includes the requested fields of the GraphQL type IPAddress.
+type CheckJobQueryNodeIPAddress struct {
+	Typename string `json:"__typename"`
 }
 
-// GetFullAppAppIpAddressesIPAddressConnection includes the requested fields of the GraphQL type IPAddressConnection.
-// The GraphQL type's documentation follows.
-//
-// The connection type for IPAddress.
-type GetFullAppAppIpAddressesIPAddressConnection struct {
-	// A list of nodes.
-	Nodes []GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress `json:"nodes"`
-}
+// GetTypename returns CheckJobQueryNodeIPAddress.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeIPAddress) GetTypename() string { return v.Typename }
 
-// GetNodes returns GetFullAppAppIpAddressesIPAddressConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppIpAddressesIPAddressConnection) GetNodes() []GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress {
-	return v.Nodes
+// CheckJobQueryNodeLoggedCertificate includes the requested fields of the GraphQL type LoggedCertificate.
+type CheckJobQueryNodeLoggedCertificate struct {
+	Typename string `json:"__typename"`
 }
 
-// GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress includes the requested fields of the GraphQL type IPAddress.
-type GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress struct {
-	Address string `json:"address"`
-	Id      string `json:"id"`
-}
+// GetTypename returns CheckJobQueryNodeLoggedCertificate.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeLoggedCertificate) GetTypename() string { return v.Typename }
 
-// GetAddress returns GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress.Address, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress) GetAddress() string {
-	return v.Address
+// CheckJobQueryNodeMachine includes the requested fields of the GraphQL type Machine.
+type CheckJobQueryNodeMachine struct {
+	Typename string `json:"__typename"`
 }
 
-// GetId returns GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress.Id, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress) GetId() string { return v.Id }
+// GetTypename returns CheckJobQueryNodeMachine.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeMachine) GetTypename() string { return v.Typename }
 
-// GetFullAppAppOrganization includes the requested fields of the GraphQL type Organization.
-type GetFullAppAppOrganization struct {
-	Id string `json:"id"`
+// CheckJobQueryNodeMachineIP includes the requested fields of the GraphQL type MachineIP.
+type CheckJobQueryNodeMachineIP struct {
+	Typename string `json:"__typename"`
 }
 
-// GetId returns GetFullAppAppOrganization.Id, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppOrganization) GetId() string { return v.Id }
+// GetTypename returns CheckJobQueryNodeMachineIP.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeMachineIP) GetTypename() string { return v.Typename }
 
-// GetFullAppAppRole includes the requested fields of the GraphQL interface AppRole.
-//
-// GetFullAppAppRole is implemented by the following types:
-// GetFullAppAppRoleEmptyAppRole
-// GetFullAppAppRoleFlyctlMachineHostAppRole
-// GetFullAppAppRolePostgresClusterAppRole
-// GetFullAppAppRoleRemoteDockerBuilderAppRole
-type GetFullAppAppRole interface {
-	implementsGraphQLInterfaceGetFullAppAppRole()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
-	// GetName returns the interface-field "name" from its implementation.
-	// The GraphQL interface field's documentation follows.
-	//
-	// The name of this role
-	GetName() string
+// CheckJobQueryNodeOrganization includes the requested fields of the GraphQL type Organization.
+type CheckJobQueryNodeOrganization struct {
+	Typename string `json:"__typename"`
 }
 
-func (v *GetFullAppAppRoleEmptyAppRole) implementsGraphQLInterfaceGetFullAppAppRole()               {}
-func (v *GetFullAppAppRoleFlyctlMachineHostAppRole) implementsGraphQLInterfaceGetFullAppAppRole()   {}
-func (v *GetFullAppAppRolePostgresClusterAppRole) implementsGraphQLInterfaceGetFullAppAppRole()     {}
-func (v *GetFullAppAppRoleRemoteDockerBuilderAppRole) implementsGraphQLInterfaceGetFullAppAppRole() {}
+// GetTypename returns CheckJobQueryNodeOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeOrganization) GetTypename() string { return v.Typename }
 
-func __unmarshalGetFullAppAppRole(b []byte, v *GetFullAppAppRole) error {
-	if string(b) == "null" {
-		return nil
-	}
+// CheckJobQueryNodeOrganizationInvitation includes the requested fields of the GraphQL type OrganizationInvitation.
+type CheckJobQueryNodeOrganizationInvitation struct {
+	Typename string `json:"__typename"`
+}
 
-	var tn struct {
-		TypeName string `json:"__typename"`
-	}
-	err := json.Unmarshal(b, &tn)
-	if err != nil {
-		return err
-	}
+// GetTypename returns CheckJobQueryNodeOrganizationInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeOrganizationInvitation) GetTypename() string { return v.Typename }
 
-	switch tn.TypeName {
-	case "EmptyAppRole":
-		*v = new(GetFullAppAppRoleEmptyAppRole)
-		return json.Unmarshal(b, *v)
-	case "FlyctlMachineHostAppRole":
-		*v = new(GetFullAppAppRoleFlyctlMachineHostAppRole)
-		return json.Unmarshal(b, *v)
-	case "PostgresClusterAppRole":
-		*v = new(GetFullAppAppRolePostgresClusterAppRole)
-		return json.Unmarshal(b, *v)
-	case "RemoteDockerBuilderAppRole":
-		*v = new(GetFullAppAppRoleRemoteDockerBuilderAppRole)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing AppRole.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for GetFullAppAppRole: "%v"`, tn.TypeName)
-	}
+// CheckJobQueryNodePostgresClusterAttachment includes the requested fields of the GraphQL type PostgresClusterAttachment.
+type CheckJobQueryNodePostgresClusterAttachment struct {
+	Typename string `json:"__typename"`
 }
 
-func __marshalGetFullAppAppRole(v *GetFullAppAppRole) ([]byte, error) {
+// GetTypename returns CheckJobQueryNodePostgresClusterAttachment.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodePostgresClusterAttachment) GetTypename() string { return v.Typename }
 
-	var typename string
-	switch v := (*v).(type) {
-	case *GetFullAppAppRoleEmptyAppRole:
-		typename = "EmptyAppRole"
+// CheckJobQueryNodeRelease includes the requested fields of the GraphQL type Release.
+type CheckJobQueryNodeRelease struct {
+	Typename string `json:"__typename"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetFullAppAppRoleEmptyAppRole
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetFullAppAppRoleFlyctlMachineHostAppRole:
-		typename = "FlyctlMachineHostAppRole"
+// GetTypename returns CheckJobQueryNodeRelease.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeRelease) GetTypename() string { return v.Typename }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetFullAppAppRoleFlyctlMachineHostAppRole
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetFullAppAppRolePostgresClusterAppRole:
-		typename = "PostgresClusterAppRole"
+// CheckJobQueryNodeReleaseCommand includes the requested fields of the GraphQL type ReleaseCommand.
+type CheckJobQueryNodeReleaseCommand struct {
+	Typename string `json:"__typename"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetFullAppAppRolePostgresClusterAppRole
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetFullAppAppRoleRemoteDockerBuilderAppRole:
-		typename = "RemoteDockerBuilderAppRole"
+// GetTypename returns CheckJobQueryNodeReleaseCommand.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeReleaseCommand) GetTypename() string { return v.Typename }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetFullAppAppRoleRemoteDockerBuilderAppRole
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for GetFullAppAppRole: "%T"`, v)
-	}
+// CheckJobQueryNodeSecret includes the requested fields of the GraphQL type Secret.
+type CheckJobQueryNodeSecret struct {
+	Typename string `json:"__typename"`
 }
 
-// GetFullAppAppRoleEmptyAppRole includes the requested fields of the GraphQL type EmptyAppRole.
-type GetFullAppAppRoleEmptyAppRole struct {
+// GetTypename returns CheckJobQueryNodeSecret.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeSecret) GetTypename() string { return v.Typename }
+
+// CheckJobQueryNodeSourceBuild includes the requested fields of the GraphQL type SourceBuild.
+type CheckJobQueryNodeSourceBuild struct {
 	Typename string `json:"__typename"`
-	// The name of this role
-	Name string `json:"name"`
 }
 
-// GetTypename returns GetFullAppAppRoleEmptyAppRole.Typename, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppRoleEmptyAppRole) GetTypename() string { return v.Typename }
-
-// GetName returns GetFullAppAppRoleEmptyAppRole.Name, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppRoleEmptyAppRole) GetName() string { return v.Name }
+// GetTypename returns CheckJobQueryNodeSourceBuild.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeSourceBuild) GetTypename() string { return v.Typename }
 
-// GetFullAppAppRoleFlyctlMachineHostAppRole includes the requested fields of the GraphQL type FlyctlMachineHostAppRole.
-type GetFullAppAppRoleFlyctlMachineHostAppRole struct {
+// CheckJobQueryNodeTemplateDeployment includes the requested fields of the GraphQL type TemplateDeployment.
+type CheckJobQueryNodeTemplateDeployment struct {
 	Typename string `json:"__typename"`
-	// The name of this role
-	Name string `json:"name"`
 }
 
-// GetTypename returns GetFullAppAppRoleFlyctlMachineHostAppRole.Typename, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppRoleFlyctlMachineHostAppRole) GetTypename() string { return v.Typename }
+// GetTypename returns CheckJobQueryNodeTemplateDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeTemplateDeployment) GetTypename() string { return v.Typename }
 
-// GetName returns GetFullAppAppRoleFlyctlMachineHostAppRole.Name, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppRoleFlyctlMachineHostAppRole) GetName() string { return v.Name }
+// CheckJobQueryNodeUser includes the requested fields of the GraphQL type User.
+type CheckJobQueryNodeUser struct {
+	Typename string `json:"__typename"`
+}
 
-// GetFullAppAppRolePostgresClusterAppRole includes the requested fields of the GraphQL type PostgresClusterAppRole.
-type GetFullAppAppRolePostgresClusterAppRole struct {
+// GetTypename returns CheckJobQueryNodeUser.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeUser) GetTypename() string { return v.Typename }
+
+// CheckJobQueryNodeVM includes the requested fields of the GraphQL type VM.
+type CheckJobQueryNodeVM struct {
 	Typename string `json:"__typename"`
-	// The name of this role
-	Name string `json:"name"`
 }
 
-// GetTypename returns GetFullAppAppRolePostgresClusterAppRole.Typename, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppRolePostgresClusterAppRole) GetTypename() string { return v.Typename }
+// GetTypename returns CheckJobQueryNodeVM.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeVM) GetTypename() string { return v.Typename }
 
-// GetName returns GetFullAppAppRolePostgresClusterAppRole.Name, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppRolePostgresClusterAppRole) GetName() string { return v.Name }
+// CheckJobQueryNodeVolume includes the requested fields of the GraphQL type Volume.
+type CheckJobQueryNodeVolume struct {
+	Typename string `json:"__typename"`
+}
 
-// GetFullAppAppRoleRemoteDockerBuilderAppRole includes the requested fields of the GraphQL type RemoteDockerBuilderAppRole.
-type GetFullAppAppRoleRemoteDockerBuilderAppRole struct {
+// GetTypename returns CheckJobQueryNodeVolume.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeVolume) GetTypename() string { return v.Typename }
+
+// CheckJobQueryNodeVolumeSnapshot includes the requested fields of the GraphQL type VolumeSnapshot.
+type CheckJobQueryNodeVolumeSnapshot struct {
 	Typename string `json:"__typename"`
-	// The name of this role
-	Name string `json:"name"`
 }
 
-// GetTypename returns GetFullAppAppRoleRemoteDockerBuilderAppRole.Typename, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppRoleRemoteDockerBuilderAppRole) GetTypename() string { return v.Typename }
+// GetTypename returns CheckJobQueryNodeVolumeSnapshot.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeVolumeSnapshot) GetTypename() string { return v.Typename }
 
-// GetName returns GetFullAppAppRoleRemoteDockerBuilderAppRole.Name, and is useful for accessing the field via an interface.
-func (v *GetFullAppAppRoleRemoteDockerBuilderAppRole) GetName() string { return v.Name }
+// CheckJobQueryNodeWireGuardPeer includes the requested fields of the GraphQL type WireGuardPeer.
+type CheckJobQueryNodeWireGuardPeer struct {
+	Typename string `json:"__typename"`
+}
 
-// GetFullAppResponse is returned by GetFullApp on success.
-type GetFullAppResponse struct {
-	// Find an app by name
-	App GetFullAppApp `json:"app"`
+// GetTypename returns CheckJobQueryNodeWireGuardPeer.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryNodeWireGuardPeer) GetTypename() string { return v.Typename }
+
+// CheckJobQueryResponse is returned by CheckJobQuery on success.
+type CheckJobQueryResponse struct {
+	// Fetches an object given its ID.
+	Node CheckJobQueryNode `json:"-"`
 }
 
-// GetApp returns GetFullAppResponse.App, and is useful for accessing the field via an interface.
-func (v *GetFullAppResponse) GetApp() GetFullAppApp { return v.App }
+// GetNode returns CheckJobQueryResponse.Node, and is useful for accessing the field via an interface.
+func (v *CheckJobQueryResponse) GetNode() CheckJobQueryNode { return v.Node }
 
-type IPAddressType string
+func (v *CheckJobQueryResponse) UnmarshalJSON(b []byte) error {
 
-const (
-	IPAddressTypeV4 IPAddressType = "v4"
-	IPAddressTypeV6 IPAddressType = "v6"
-)
+	if string(b) == "null" {
+		return nil
+	}
 
-// IpAddressQueryApp includes the requested fields of the GraphQL type App.
-type IpAddressQueryApp struct {
-	// Find an ip address by address string
-	IpAddress IpAddressQueryAppIpAddressIPAddress `json:"ipAddress"`
-}
+	var firstPass struct {
+		*CheckJobQueryResponse
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CheckJobQueryResponse = v
 
-// GetIpAddress returns IpAddressQueryApp.IpAddress, and is useful for accessing the field via an interface.
-func (v *IpAddressQueryApp) GetIpAddress() IpAddressQueryAppIpAddressIPAddress { return v.IpAddress }
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// IpAddressQueryAppIpAddressIPAddress includes the requested fields of the GraphQL type IPAddress.
-type IpAddressQueryAppIpAddressIPAddress struct {
-	Id      string        `json:"id"`
-	Type    IPAddressType `json:"type"`
-	Address string        `json:"address"`
-	Region  string        `json:"region"`
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalCheckJobQueryNode(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal CheckJobQueryResponse.Node: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetId returns IpAddressQueryAppIpAddressIPAddress.Id, and is useful for accessing the field via an interface.
-func (v *IpAddressQueryAppIpAddressIPAddress) GetId() string { return v.Id }
+type __premarshalCheckJobQueryResponse struct {
+	Node json.RawMessage `json:"node"`
+}
 
-// GetType returns IpAddressQueryAppIpAddressIPAddress.Type, and is useful for accessing the field via an interface.
-func (v *IpAddressQueryAppIpAddressIPAddress) GetType() IPAddressType { return v.Type }
+func (v *CheckJobQueryResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-// GetAddress returns IpAddressQueryAppIpAddressIPAddress.Address, and is useful for accessing the field via an interface.
-func (v *IpAddressQueryAppIpAddressIPAddress) GetAddress() string { return v.Address }
+func (v *CheckJobQueryResponse) __premarshalJSON() (*__premarshalCheckJobQueryResponse, error) {
+	var retval __premarshalCheckJobQueryResponse
 
-// GetRegion returns IpAddressQueryAppIpAddressIPAddress.Region, and is useful for accessing the field via an interface.
-func (v *IpAddressQueryAppIpAddressIPAddress) GetRegion() string { return v.Region }
+	{
 
-// IpAddressQueryResponse is returned by IpAddressQuery on success.
-type IpAddressQueryResponse struct {
-	// Find an app by name
-	App IpAddressQueryApp `json:"app"`
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalCheckJobQueryNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal CheckJobQueryResponse.Node: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// GetApp returns IpAddressQueryResponse.App, and is useful for accessing the field via an interface.
-func (v *IpAddressQueryResponse) GetApp() IpAddressQueryApp { return v.App }
-
-// OrgsQueryOrganizationsOrganizationConnection includes the requested fields of the GraphQL type OrganizationConnection.
+// CheckJobRunsQueryNode includes the requested fields of the GraphQL interface Node.
+//
+// CheckJobRunsQueryNode is implemented by the following types:
+// CheckJobRunsQueryNodeAccessToken
+// CheckJobRunsQueryNodeAllocation
+// CheckJobRunsQueryNodeApp
+// CheckJobRunsQueryNodeAppCertificate
+// CheckJobRunsQueryNodeAppChange
+// CheckJobRunsQueryNodeBuild
+// CheckJobRunsQueryNodeCertificate
+// CheckJobRunsQueryNodeCheckHTTPResponse
+// CheckJobRunsQueryNodeCheckJob
+// CheckJobRunsQueryNodeCheckJobRun
+// CheckJobRunsQueryNodeDelegatedWireGuardToken
+// CheckJobRunsQueryNodeDNSPortal
+// CheckJobRunsQueryNodeDNSPortalSession
+// CheckJobRunsQueryNodeDNSRecord
+// CheckJobRunsQueryNodeDomain
+// CheckJobRunsQueryNodeHost
+// CheckJobRunsQueryNodeIPAddress
+// CheckJobRunsQueryNodeLoggedCertificate
+// CheckJobRunsQueryNodeMachine
+// CheckJobRunsQueryNodeMachineIP
+// CheckJobRunsQueryNodeOrganization
+// CheckJobRunsQueryNodeOrganizationInvitation
+// CheckJobRunsQueryNodePostgresClusterAttachment
+// CheckJobRunsQueryNodeRelease
+// CheckJobRunsQueryNodeReleaseCommand
+// CheckJobRunsQueryNodeSecret
+// CheckJobRunsQueryNodeSourceBuild
+// CheckJobRunsQueryNodeTemplateDeployment
+// CheckJobRunsQueryNodeUser
+// CheckJobRunsQueryNodeVM
+// CheckJobRunsQueryNodeVolume
+// CheckJobRunsQueryNodeVolumeSnapshot
+// CheckJobRunsQueryNodeWireGuardPeer
 // The GraphQL type's documentation follows.
 //
-// The connection type for Organization.
-type OrgsQueryOrganizationsOrganizationConnection struct {
-	// A list of nodes.
-	Nodes []OrgsQueryOrganizationsOrganizationConnectionNodesOrganization `json:"nodes"`
+// An object with an ID.
+type CheckJobRunsQueryNode interface {
+	implementsGraphQLInterfaceCheckJobRunsQueryNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
 }
 
-// GetNodes returns OrgsQueryOrganizationsOrganizationConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *OrgsQueryOrganizationsOrganizationConnection) GetNodes() []OrgsQueryOrganizationsOrganizationConnectionNodesOrganization {
-	return v.Nodes
-}
+func (v *CheckJobRunsQueryNodeAccessToken) implementsGraphQLInterfaceCheckJobRunsQueryNode()       {}
+func (v *CheckJobRunsQueryNodeAllocation) implementsGraphQLInterfaceCheckJobRunsQueryNode()        {}
+func (v *CheckJobRunsQueryNodeApp) implementsGraphQLInterfaceCheckJobRunsQueryNode()               {}
+func (v *CheckJobRunsQueryNodeAppCertificate) implementsGraphQLInterfaceCheckJobRunsQueryNode()    {}
+func (v *CheckJobRunsQueryNodeAppChange) implementsGraphQLInterfaceCheckJobRunsQueryNode()         {}
+func (v *CheckJobRunsQueryNodeBuild) implementsGraphQLInterfaceCheckJobRunsQueryNode()             {}
+func (v *CheckJobRunsQueryNodeCertificate) implementsGraphQLInterfaceCheckJobRunsQueryNode()       {}
+func (v *CheckJobRunsQueryNodeCheckHTTPResponse) implementsGraphQLInterfaceCheckJobRunsQueryNode() {}
+func (v *CheckJobRunsQueryNodeCheckJob) implementsGraphQLInterfaceCheckJobRunsQueryNode()          {}
+func (v *CheckJobRunsQueryNodeCheckJobRun) implementsGraphQLInterfaceCheckJobRunsQueryNode()       {}
+func (v *CheckJobRunsQueryNodeDelegatedWireGuardToken) implementsGraphQLInterfaceCheckJobRunsQueryNode() {
+}
+func (v *CheckJobRunsQueryNodeDNSPortal) implementsGraphQLInterfaceCheckJobRunsQueryNode()         {}
+func (v *CheckJobRunsQueryNodeDNSPortalSession) implementsGraphQLInterfaceCheckJobRunsQueryNode()  {}
+func (v *CheckJobRunsQueryNodeDNSRecord) implementsGraphQLInterfaceCheckJobRunsQueryNode()         {}
+func (v *CheckJobRunsQueryNodeDomain) implementsGraphQLInterfaceCheckJobRunsQueryNode()            {}
+func (v *CheckJobRunsQueryNodeHost) implementsGraphQLInterfaceCheckJobRunsQueryNode()              {}
+func (v *CheckJobRunsQueryNodeIPAddress) implementsGraphQLInterfaceCheckJobRunsQueryNode()         {}
+func (v *CheckJobRunsQueryNodeLoggedCertificate) implementsGraphQLInterfaceCheckJobRunsQueryNode() {}
+func (v *CheckJobRunsQueryNodeMachine) implementsGraphQLInterfaceCheckJobRunsQueryNode()           {}
+func (v *CheckJobRunsQueryNodeMachineIP) implementsGraphQLInterfaceCheckJobRunsQueryNode()         {}
+func (v *CheckJobRunsQueryNodeOrganization) implementsGraphQLInterfaceCheckJobRunsQueryNode()      {}
+func (v *CheckJobRunsQueryNodeOrganizationInvitation) implementsGraphQLInterfaceCheckJobRunsQueryNode() {
+}
+func (v *CheckJobRunsQueryNodePostgresClusterAttachment) implementsGraphQLInterfaceCheckJobRunsQueryNode() {
+}
+func (v *CheckJobRunsQueryNodeRelease) implementsGraphQLInterfaceCheckJobRunsQueryNode()            {}
+func (v *CheckJobRunsQueryNodeReleaseCommand) implementsGraphQLInterfaceCheckJobRunsQueryNode()     {}
+func (v *CheckJobRunsQueryNodeSecret) implementsGraphQLInterfaceCheckJobRunsQueryNode()             {}
+func (v *CheckJobRunsQueryNodeSourceBuild) implementsGraphQLInterfaceCheckJobRunsQueryNode()        {}
+func (v *CheckJobRunsQueryNodeTemplateDeployment) implementsGraphQLInterfaceCheckJobRunsQueryNode() {}
+func (v *CheckJobRunsQueryNodeUser) implementsGraphQLInterfaceCheckJobRunsQueryNode()               {}
+func (v *CheckJobRunsQueryNodeVM) implementsGraphQLInterfaceCheckJobRunsQueryNode()                 {}
+func (v *CheckJobRunsQueryNodeVolume) implementsGraphQLInterfaceCheckJobRunsQueryNode()             {}
+func (v *CheckJobRunsQueryNodeVolumeSnapshot) implementsGraphQLInterfaceCheckJobRunsQueryNode()     {}
+func (v *CheckJobRunsQueryNodeWireGuardPeer) implementsGraphQLInterfaceCheckJobRunsQueryNode()      {}
+
+func __unmarshalCheckJobRunsQueryNode(b []byte, v *CheckJobRunsQueryNode) error {
+	if string(b) == "null" {
+		return nil
+	}
 
-// OrgsQueryOrganizationsOrganizationConnectionNodesOrganization includes the requested fields of the GraphQL type Organization.
-type OrgsQueryOrganizationsOrganizationConnectionNodesOrganization struct {
-	// Organization name
-	Name string `json:"name"`
-	Id   string `json:"id"`
-}
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
 
-// GetName returns OrgsQueryOrganizationsOrganizationConnectionNodesOrganization.Name, and is useful for accessing the field via an interface.
-func (v *OrgsQueryOrganizationsOrganizationConnectionNodesOrganization) GetName() string {
-	return v.Name
+	switch tn.TypeName {
+	case "AccessToken":
+		*v = new(CheckJobRunsQueryNodeAccessToken)
+		return json.Unmarshal(b, *v)
+	case "Allocation":
+		*v = new(CheckJobRunsQueryNodeAllocation)
+		return json.Unmarshal(b, *v)
+	case "App":
+		*v = new(CheckJobRunsQueryNodeApp)
+		return json.Unmarshal(b, *v)
+	case "AppCertificate":
+		*v = new(CheckJobRunsQueryNodeAppCertificate)
+		return json.Unmarshal(b, *v)
+	case "AppChange":
+		*v = new(CheckJobRunsQueryNodeAppChange)
+		return json.Unmarshal(b, *v)
+	case "Build":
+		*v = new(CheckJobRunsQueryNodeBuild)
+		return json.Unmarshal(b, *v)
+	case "Certificate":
+		*v = new(CheckJobRunsQueryNodeCertificate)
+		return json.Unmarshal(b, *v)
+	case "CheckHTTPResponse":
+		*v = new(CheckJobRunsQueryNodeCheckHTTPResponse)
+		return json.Unmarshal(b, *v)
+	case "CheckJob":
+		*v = new(CheckJobRunsQueryNodeCheckJob)
+		return json.Unmarshal(b, *v)
+	case "CheckJobRun":
+		*v = new(CheckJobRunsQueryNodeCheckJobRun)
+		return json.Unmarshal(b, *v)
+	case "DelegatedWireGuardToken":
+		*v = new(CheckJobRunsQueryNodeDelegatedWireGuardToken)
+		return json.Unmarshal(b, *v)
+	case "DNSPortal":
+		*v = new(CheckJobRunsQueryNodeDNSPortal)
+		return json.Unmarshal(b, *v)
+	case "DNSPortalSession":
+		*v = new(CheckJobRunsQueryNodeDNSPortalSession)
+		return json.Unmarshal(b, *v)
+	case "DNSRecord":
+		*v = new(CheckJobRunsQueryNodeDNSRecord)
+		return json.Unmarshal(b, *v)
+	case "Domain":
+		*v = new(CheckJobRunsQueryNodeDomain)
+		return json.Unmarshal(b, *v)
+	case "Host":
+		*v = new(CheckJobRunsQueryNodeHost)
+		return json.Unmarshal(b, *v)
+	case "IPAddress":
+		*v = new(CheckJobRunsQueryNodeIPAddress)
+		return json.Unmarshal(b, *v)
+	case "LoggedCertificate":
+		*v = new(CheckJobRunsQueryNodeLoggedCertificate)
+		return json.Unmarshal(b, *v)
+	case "Machine":
+		*v = new(CheckJobRunsQueryNodeMachine)
+		return json.Unmarshal(b, *v)
+	case "MachineIP":
+		*v = new(CheckJobRunsQueryNodeMachineIP)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(CheckJobRunsQueryNodeOrganization)
+		return json.Unmarshal(b, *v)
+	case "OrganizationInvitation":
+		*v = new(CheckJobRunsQueryNodeOrganizationInvitation)
+		return json.Unmarshal(b, *v)
+	case "PostgresClusterAttachment":
+		*v = new(CheckJobRunsQueryNodePostgresClusterAttachment)
+		return json.Unmarshal(b, *v)
+	case "Release":
+		*v = new(CheckJobRunsQueryNodeRelease)
+		return json.Unmarshal(b, *v)
+	case "ReleaseCommand":
+		*v = new(CheckJobRunsQueryNodeReleaseCommand)
+		return json.Unmarshal(b, *v)
+	case "Secret":
+		*v = new(CheckJobRunsQueryNodeSecret)
+		return json.Unmarshal(b, *v)
+	case "SourceBuild":
+		*v = new(CheckJobRunsQueryNodeSourceBuild)
+		return json.Unmarshal(b, *v)
+	case "TemplateDeployment":
+		*v = new(CheckJobRunsQueryNodeTemplateDeployment)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(CheckJobRunsQueryNodeUser)
+		return json.Unmarshal(b, *v)
+	case "VM":
+		*v = new(CheckJobRunsQueryNodeVM)
+		return json.Unmarshal(b, *v)
+	case "Volume":
+		*v = new(CheckJobRunsQueryNodeVolume)
+		return json.Unmarshal(b, *v)
+	case "VolumeSnapshot":
+		*v = new(CheckJobRunsQueryNodeVolumeSnapshot)
+		return json.Unmarshal(b, *v)
+	case "WireGuardPeer":
+		*v = new(CheckJobRunsQueryNodeWireGuardPeer)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Node.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for CheckJobRunsQueryNode: "%v"`, tn.TypeName)
+	}
 }
 
-// GetId returns OrgsQueryOrganizationsOrganizationConnectionNodesOrganization.Id, and is useful for accessing the field via an interface.
-func (v *OrgsQueryOrganizationsOrganizationConnectionNodesOrganization) GetId() string { return v.Id }
+func __marshalCheckJobRunsQueryNode(v *CheckJobRunsQueryNode) ([]byte, error) {
 
-// OrgsQueryResponse is returned by OrgsQuery on success.
-type OrgsQueryResponse struct {
-	Organizations OrgsQueryOrganizationsOrganizationConnection `json:"organizations"`
-}
+	var typename string
+	switch v := (*v).(type) {
+	case *CheckJobRunsQueryNodeAccessToken:
+		typename = "AccessToken"
 
-// GetOrganizations returns OrgsQueryResponse.Organizations, and is useful for accessing the field via an interface.
-func (v *OrgsQueryResponse) GetOrganizations() OrgsQueryOrganizationsOrganizationConnection {
-	return v.Organizations
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeAccessToken
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeAllocation:
+		typename = "Allocation"
 
-// ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload includes the requested fields of the GraphQL type ReleaseIPAddressPayload.
-// The GraphQL type's documentation follows.
-//
-// Autogenerated return type of ReleaseIPAddress
-type ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload struct {
-	App ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp `json:"app"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeAllocation
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeApp:
+		typename = "App"
 
-// GetApp returns ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload.App, and is useful for accessing the field via an interface.
-func (v *ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload) GetApp() ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp {
-	return v.App
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeAppCertificate:
+		typename = "AppCertificate"
 
-// ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp includes the requested fields of the GraphQL type App.
-type ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp struct {
-	// The unique application name
-	Name string `json:"name"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeAppCertificate
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeAppChange:
+		typename = "AppChange"
 
-// GetName returns ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp.Name, and is useful for accessing the field via an interface.
-func (v *ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp) GetName() string { return v.Name }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeAppChange
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeBuild:
+		typename = "Build"
 
-// ReleaseIpAddressResponse is returned by ReleaseIpAddress on success.
-type ReleaseIpAddressResponse struct {
-	ReleaseIpAddress ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload `json:"releaseIpAddress"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeBuild
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeCertificate:
+		typename = "Certificate"
 
-// GetReleaseIpAddress returns ReleaseIpAddressResponse.ReleaseIpAddress, and is useful for accessing the field via an interface.
-func (v *ReleaseIpAddressResponse) GetReleaseIpAddress() ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload {
-	return v.ReleaseIpAddress
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeCertificate
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeCheckHTTPResponse:
+		typename = "CheckHTTPResponse"
 
-// Autogenerated input type of RemoveWireGuardPeer
-type RemoveWireGuardPeerInput struct {
-	// A unique identifier for the client performing the mutation.
-	ClientMutationId string `json:"clientMutationId"`
-	// The node ID of the organization
-	OrganizationId string `json:"organizationId"`
-	// The name of the peer to remove
-	Name string `json:"name"`
-	// Add via NATS transaction (for testing only, nosy users)
-	Nats bool `json:"nats"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeCheckHTTPResponse
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeCheckJob:
+		typename = "CheckJob"
 
-// GetClientMutationId returns RemoveWireGuardPeerInput.ClientMutationId, and is useful for accessing the field via an interface.
-func (v *RemoveWireGuardPeerInput) GetClientMutationId() string { return v.ClientMutationId }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeCheckJob
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeCheckJobRun:
+		typename = "CheckJobRun"
 
-// GetOrganizationId returns RemoveWireGuardPeerInput.OrganizationId, and is useful for accessing the field via an interface.
-func (v *RemoveWireGuardPeerInput) GetOrganizationId() string { return v.OrganizationId }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeCheckJobRun
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeDelegatedWireGuardToken:
+		typename = "DelegatedWireGuardToken"
 
-// GetName returns RemoveWireGuardPeerInput.Name, and is useful for accessing the field via an interface.
-func (v *RemoveWireGuardPeerInput) GetName() string { return v.Name }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeDelegatedWireGuardToken
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeDNSPortal:
+		typename = "DNSPortal"
 
-// GetNats returns RemoveWireGuardPeerInput.Nats, and is useful for accessing the field via an interface.
-func (v *RemoveWireGuardPeerInput) GetNats() bool { return v.Nats }
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeDNSPortal
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeDNSPortalSession:
+		typename = "DNSPortalSession"
 
-// RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload includes the requested fields of the GraphQL type RemoveWireGuardPeerPayload.
-// The GraphQL type's documentation follows.
-//
-// Autogenerated return type of RemoveWireGuardPeer
-type RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload struct {
-	// The organization that owned the peer
-	Organization RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization `json:"organization"`
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeDNSPortalSession
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeDNSRecord:
+		typename = "DNSRecord"
 
-// GetOrganization returns RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload.Organization, and is useful for accessing the field via an interface.
-func (v *RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload) GetOrganization() RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization {
-	return v.Organization
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeDNSRecord
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeDomain:
+		typename = "Domain"
 
-// RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization includes the requested fields of the GraphQL type Organization.
-type RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization struct {
-	// Organization name
-	Name string `json:"name"`
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeDomain
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeHost:
+		typename = "Host"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeHost
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeIPAddress:
+		typename = "IPAddress"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeIPAddress
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeLoggedCertificate:
+		typename = "LoggedCertificate"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeLoggedCertificate
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeMachine:
+		typename = "Machine"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeMachine
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeMachineIP:
+		typename = "MachineIP"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeMachineIP
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeOrganizationInvitation:
+		typename = "OrganizationInvitation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeOrganizationInvitation
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodePostgresClusterAttachment:
+		typename = "PostgresClusterAttachment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodePostgresClusterAttachment
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeRelease:
+		typename = "Release"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeRelease
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeReleaseCommand:
+		typename = "ReleaseCommand"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeReleaseCommand
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeSecret:
+		typename = "Secret"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeSecret
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeSourceBuild:
+		typename = "SourceBuild"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeSourceBuild
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeTemplateDeployment:
+		typename = "TemplateDeployment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeTemplateDeployment
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeVM:
+		typename = "VM"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeVM
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeVolume:
+		typename = "Volume"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeVolume
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeVolumeSnapshot:
+		typename = "VolumeSnapshot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeVolumeSnapshot
+		}{typename, v}
+		return json.Marshal(result)
+	case *CheckJobRunsQueryNodeWireGuardPeer:
+		typename = "WireGuardPeer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*CheckJobRunsQueryNodeWireGuardPeer
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for CheckJobRunsQueryNode: "%T"`, v)
+	}
 }
 
-// GetName returns RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization.Name, and is useful for accessing the field via an interface.
-func (v *RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization) GetName() string {
-	return v.Name
+// CheckJobRunsQueryNodeAccessToken includes the requested fields of the GraphQL type AccessToken.
+type CheckJobRunsQueryNodeAccessToken struct {
+	Typename string `json:"__typename"`
 }
 
-// RemoveWireguardPeerResponse is returned by RemoveWireguardPeer on success.
-type RemoveWireguardPeerResponse struct {
-	RemoveWireGuardPeer RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload `json:"removeWireGuardPeer"`
+// GetTypename returns CheckJobRunsQueryNodeAccessToken.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeAccessToken) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeAllocation includes the requested fields of the GraphQL type Allocation.
+type CheckJobRunsQueryNodeAllocation struct {
+	Typename string `json:"__typename"`
 }
 
-// GetRemoveWireGuardPeer returns RemoveWireguardPeerResponse.RemoveWireGuardPeer, and is useful for accessing the field via an interface.
-func (v *RemoveWireguardPeerResponse) GetRemoveWireGuardPeer() RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload {
-	return v.RemoveWireGuardPeer
+// GetTypename returns CheckJobRunsQueryNodeAllocation.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeAllocation) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeApp includes the requested fields of the GraphQL type App.
+type CheckJobRunsQueryNodeApp struct {
+	Typename string `json:"__typename"`
 }
 
-// UpdateAutoScaleConfigMutationResponse is returned by UpdateAutoScaleConfigMutation on success.
-type UpdateAutoScaleConfigMutationResponse struct {
-	UpdateAutoscaleConfig UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload `json:"updateAutoscaleConfig"`
+// GetTypename returns CheckJobRunsQueryNodeApp.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeApp) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeAppCertificate includes the requested fields of the GraphQL type AppCertificate.
+type CheckJobRunsQueryNodeAppCertificate struct {
+	Typename string `json:"__typename"`
 }
 
-// GetUpdateAutoscaleConfig returns UpdateAutoScaleConfigMutationResponse.UpdateAutoscaleConfig, and is useful for accessing the field via an interface.
-func (v *UpdateAutoScaleConfigMutationResponse) GetUpdateAutoscaleConfig() UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload {
-	return v.UpdateAutoscaleConfig
+// GetTypename returns CheckJobRunsQueryNodeAppCertificate.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeAppCertificate) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeAppChange includes the requested fields of the GraphQL type AppChange.
+type CheckJobRunsQueryNodeAppChange struct {
+	Typename string `json:"__typename"`
 }
 
-// UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload includes the requested fields of the GraphQL type UpdateAutoscaleConfigPayload.
+// GetTypename returns CheckJobRunsQueryNodeAppChange.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeAppChange) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeBuild includes the requested fields of the GraphQL type Build.
+type CheckJobRunsQueryNodeBuild struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns CheckJobRunsQueryNodeBuild.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeBuild) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeCertificate includes the requested fields of the GraphQL type Certificate.
+type CheckJobRunsQueryNodeCertificate struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns CheckJobRunsQueryNodeCertificate.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCertificate) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeCheckHTTPResponse includes the requested fields of the GraphQL type CheckHTTPResponse.
 // The GraphQL type's documentation follows.
 //
-// Autogenerated return type of UpdateAutoscaleConfig
-type UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload struct {
-	App UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp `json:"app"`
+// check job http response
+type CheckJobRunsQueryNodeCheckHTTPResponse struct {
+	Typename string `json:"__typename"`
 }
 
-// GetApp returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload.App, and is useful for accessing the field via an interface.
-func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload) GetApp() UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp {
-	return v.App
-}
+// GetTypename returns CheckJobRunsQueryNodeCheckHTTPResponse.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckHTTPResponse) GetTypename() string { return v.Typename }
 
-// UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp includes the requested fields of the GraphQL type App.
-type UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp struct {
-	// Application status
-	Status      string                                                                                                        `json:"status"`
-	Autoscaling UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig `json:"autoscaling"`
+// CheckJobRunsQueryNodeCheckJob includes the requested fields of the GraphQL type CheckJob.
+// The GraphQL type's documentation follows.
+//
+// check job
+type CheckJobRunsQueryNodeCheckJob struct {
+	Typename string                                                 `json:"__typename"`
+	Runs     CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnection `json:"runs"`
 }
 
-// GetStatus returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp.Status, and is useful for accessing the field via an interface.
-func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp) GetStatus() string {
-	return v.Status
+// GetTypename returns CheckJobRunsQueryNodeCheckJob.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJob) GetTypename() string { return v.Typename }
+
+// GetRuns returns CheckJobRunsQueryNodeCheckJob.Runs, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJob) GetRuns() CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnection {
+	return v.Runs
 }
 
-// GetAutoscaling returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp.Autoscaling, and is useful for accessing the field via an interface.
-func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp) GetAutoscaling() UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig {
-	return v.Autoscaling
+// CheckJobRunsQueryNodeCheckJobRun includes the requested fields of the GraphQL type CheckJobRun.
+// The GraphQL type's documentation follows.
+//
+// check job run
+type CheckJobRunsQueryNodeCheckJobRun struct {
+	Typename string `json:"__typename"`
 }
 
-// UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
-type UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig struct {
-	Regions []UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig `json:"regions"`
+// GetTypename returns CheckJobRunsQueryNodeCheckJobRun.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRun) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnection includes the requested fields of the GraphQL type CheckJobRunConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for CheckJobRun.
+type CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnection struct {
+	// A list of nodes.
+	Nodes []CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun `json:"nodes"`
 }
 
-// GetRegions returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig.Regions, and is useful for accessing the field via an interface.
-func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig) GetRegions() []UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig {
-	return v.Regions
+// GetNodes returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnection) GetNodes() []CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun {
+	return v.Nodes
 }
 
-// UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig includes the requested fields of the GraphQL type AutoscaleRegionConfig.
-type UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig struct {
-	// The region code
-	Code string `json:"code"`
+// CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun includes the requested fields of the GraphQL type CheckJobRun.
+// The GraphQL type's documentation follows.
+//
+// check job run
+type CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun struct {
+	Id            string                                                                                                         `json:"id"`
+	State         string                                                                                                         `json:"state"`
+	Tests         []string                                                                                                       `json:"tests"`
+	Url           string                                                                                                         `json:"url"`
+	CreatedAt     time.Time                                                                                                      `json:"createdAt"`
+	CompletedAt   time.Time                                                                                                      `json:"completedAt"`
+	HttpResponses CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnection `json:"httpResponses"`
+}
+
+// GetId returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun.Id, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun) GetId() string {
+	return v.Id
 }
 
-// GetCode returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig.Code, and is useful for accessing the field via an interface.
-func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) GetCode() string {
-	return v.Code
+// GetState returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun.State, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun) GetState() string {
+	return v.State
 }
 
-// VolumeQueryApp includes the requested fields of the GraphQL type App.
-type VolumeQueryApp struct {
-	Volume VolumeQueryAppVolume `json:"volume"`
+// GetTests returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun.Tests, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun) GetTests() []string {
+	return v.Tests
 }
 
-// GetVolume returns VolumeQueryApp.Volume, and is useful for accessing the field via an interface.
-func (v *VolumeQueryApp) GetVolume() VolumeQueryAppVolume { return v.Volume }
+// GetUrl returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun.Url, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun) GetUrl() string {
+	return v.Url
+}
 
-// VolumeQueryAppVolume includes the requested fields of the GraphQL type Volume.
-type VolumeQueryAppVolume struct {
-	Name       string `json:"name"`
-	Region     string `json:"region"`
-	Id         string `json:"id"`
-	InternalId string `json:"internalId"`
-	SizeGb     int    `json:"sizeGb"`
+// GetCreatedAt returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun) GetCreatedAt() time.Time {
+	return v.CreatedAt
 }
 
-// GetName returns VolumeQueryAppVolume.Name, and is useful for accessing the field via an interface.
-func (v *VolumeQueryAppVolume) GetName() string { return v.Name }
+// GetCompletedAt returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun.CompletedAt, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun) GetCompletedAt() time.Time {
+	return v.CompletedAt
+}
 
-// GetRegion returns VolumeQueryAppVolume.Region, and is useful for accessing the field via an interface.
-func (v *VolumeQueryAppVolume) GetRegion() string { return v.Region }
+// GetHttpResponses returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun.HttpResponses, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRun) GetHttpResponses() CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnection {
+	return v.HttpResponses
+}
 
-// GetId returns VolumeQueryAppVolume.Id, and is useful for accessing the field via an interface.
-func (v *VolumeQueryAppVolume) GetId() string { return v.Id }
+// CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnection includes the requested fields of the GraphQL type CheckHTTPResponseConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for CheckHTTPResponse.
+type CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnection struct {
+	// A list of nodes.
+	Nodes []CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse `json:"nodes"`
+}
 
-// GetInternalId returns VolumeQueryAppVolume.InternalId, and is useful for accessing the field via an interface.
-func (v *VolumeQueryAppVolume) GetInternalId() string { return v.InternalId }
+// GetNodes returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnection) GetNodes() []CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse {
+	return v.Nodes
+}
 
-// GetSizeGb returns VolumeQueryAppVolume.SizeGb, and is useful for accessing the field via an interface.
-func (v *VolumeQueryAppVolume) GetSizeGb() int { return v.SizeGb }
+// CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse includes the requested fields of the GraphQL type CheckHTTPResponse.
+// The GraphQL type's documentation follows.
+//
+// check job http response
+type CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse struct {
+	StatusCode int                                                                                                                                                       `json:"statusCode"`
+	ResolvedIp string                                                                                                                                                    `json:"resolvedIp"`
+	Location   CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponseLocationCheckLocation `json:"location"`
+}
 
-// VolumeQueryResponse is returned by VolumeQuery on success.
-type VolumeQueryResponse struct {
-	// Find an app by name
-	App VolumeQueryApp `json:"app"`
+// GetStatusCode returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse.StatusCode, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse) GetStatusCode() int {
+	return v.StatusCode
 }
 
-// GetApp returns VolumeQueryResponse.App, and is useful for accessing the field via an interface.
-func (v *VolumeQueryResponse) GetApp() VolumeQueryApp { return v.App }
+// GetResolvedIp returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse.ResolvedIp, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse) GetResolvedIp() string {
+	return v.ResolvedIp
+}
 
-// __AddCertificateInput is used internally by genqlient
-type __AddCertificateInput struct {
-	App      string `json:"app"`
-	Hostname string `json:"hostname"`
+// GetLocation returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse.Location, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponse) GetLocation() CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponseLocationCheckLocation {
+	return v.Location
 }
 
-// GetApp returns __AddCertificateInput.App, and is useful for accessing the field via an interface.
-func (v *__AddCertificateInput) GetApp() string { return v.App }
+// CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponseLocationCheckLocation includes the requested fields of the GraphQL type CheckLocation.
+// The GraphQL type's documentation follows.
+//
+// check location
+type CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponseLocationCheckLocation struct {
+	Name string `json:"name"`
+}
 
-// GetHostname returns __AddCertificateInput.Hostname, and is useful for accessing the field via an interface.
-func (v *__AddCertificateInput) GetHostname() string { return v.Hostname }
+// GetName returns CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponseLocationCheckLocation.Name, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeCheckJobRunsCheckJobRunConnectionNodesCheckJobRunHttpResponsesCheckHTTPResponseConnectionNodesCheckHTTPResponseLocationCheckLocation) GetName() string {
+	return v.Name
+}
 
-// __AddWireguardPeerInput is used internally by genqlient
-type __AddWireguardPeerInput struct {
-	Input AddWireGuardPeerInput `json:"input"`
+// CheckJobRunsQueryNodeDNSPortal includes the requested fields of the GraphQL type DNSPortal.
+type CheckJobRunsQueryNodeDNSPortal struct {
+	Typename string `json:"__typename"`
 }
 
-// GetInput returns __AddWireguardPeerInput.Input, and is useful for accessing the field via an interface.
-func (v *__AddWireguardPeerInput) GetInput() AddWireGuardPeerInput { return v.Input }
+// GetTypename returns CheckJobRunsQueryNodeDNSPortal.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeDNSPortal) GetTypename() string { return v.Typename }
 
-// __AllocateIpAddressInput is used internally by genqlient
-type __AllocateIpAddressInput struct {
-	App      string        `json:"app"`
-	Region   string        `json:"region"`
-	AddrType IPAddressType `json:"addrType"`
+// CheckJobRunsQueryNodeDNSPortalSession includes the requested fields of the GraphQL type DNSPortalSession.
+type CheckJobRunsQueryNodeDNSPortalSession struct {
+	Typename string `json:"__typename"`
 }
 
-// GetApp returns __AllocateIpAddressInput.App, and is useful for accessing the field via an interface.
-func (v *__AllocateIpAddressInput) GetApp() string { return v.App }
+// GetTypename returns CheckJobRunsQueryNodeDNSPortalSession.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeDNSPortalSession) GetTypename() string { return v.Typename }
 
-// GetRegion returns __AllocateIpAddressInput.Region, and is useful for accessing the field via an interface.
-func (v *__AllocateIpAddressInput) GetRegion() string { return v.Region }
+// CheckJobRunsQueryNodeDNSRecord includes the requested fields of the GraphQL type DNSRecord.
+type CheckJobRunsQueryNodeDNSRecord struct {
+	Typename string `json:"__typename"`
+}
 
-// GetAddrType returns __AllocateIpAddressInput.AddrType, and is useful for accessing the field via an interface.
-func (v *__AllocateIpAddressInput) GetAddrType() IPAddressType { return v.AddrType }
+// GetTypename returns CheckJobRunsQueryNodeDNSRecord.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeDNSRecord) GetTypename() string { return v.Typename }
 
-// __CreateAppMutationInput is used internally by genqlient
-type __CreateAppMutationInput struct {
-	Name            string `json:"name"`
-	OrganizationId  string `json:"organizationId"`
-	PreferredRegion string `json:"preferredRegion"`
-	Network         string `json:"network"`
+// CheckJobRunsQueryNodeDelegatedWireGuardToken includes the requested fields of the GraphQL type DelegatedWireGuardToken.
+type CheckJobRunsQueryNodeDelegatedWireGuardToken struct {
+	Typename string `json:"__typename"`
 }
 
-// GetName returns __CreateAppMutationInput.Name, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationInput) GetName() string { return v.Name }
+// GetTypename returns CheckJobRunsQueryNodeDelegatedWireGuardToken.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeDelegatedWireGuardToken) GetTypename() string { return v.Typename }
 
-// GetOrganizationId returns __CreateAppMutationInput.OrganizationId, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationInput) GetOrganizationId() string { return v.OrganizationId }
+// CheckJobRunsQueryNodeDomain includes the requested fields of the GraphQL type Domain.
+type CheckJobRunsQueryNodeDomain struct {
+	Typename string `json:"__typename"`
+}
 
-// GetPreferredRegion returns __CreateAppMutationInput.PreferredRegion, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationInput) GetPreferredRegion() string { return v.PreferredRegion }
+// GetTypename returns CheckJobRunsQueryNodeDomain.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeDomain) GetTypename() string { return v.Typename }
 
-// GetNetwork returns __CreateAppMutationInput.Network, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationInput) GetNetwork() string { return v.Network }
+// CheckJobRunsQueryNodeHost includes the requested fields of the GraphQL type Host.
+type CheckJobRunsQueryNodeHost struct {
+	Typename string `json:"__typename"`
+}
 
-// __CreateAppMutationWithAutoscaleConfigInput is used internally by genqlient
-type __CreateAppMutationWithAutoscaleConfigInput struct {
-	Name            string                       `json:"name"`
-	Id              string                       `json:"id"`
-	OrganizationId  string                       `json:"organizationId"`
-	PreferredRegion string                       `json:"preferredRegion"`
-	Network         string                       `json:"network"`
-	Regions         []AutoscaleRegionConfigInput `json:"regions"`
+// GetTypename returns CheckJobRunsQueryNodeHost.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeHost) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeIPAddress includes the requested fields of the GraphQL type IPAddress.
+type CheckJobRunsQueryNodeIPAddress struct {
+	Typename string `json:"__typename"`
 }
 
-// GetName returns __CreateAppMutationWithAutoscaleConfigInput.Name, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationWithAutoscaleConfigInput) GetName() string { return v.Name }
+// GetTypename returns CheckJobRunsQueryNodeIPAddress.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeIPAddress) GetTypename() string { return v.Typename }
 
-// GetId returns __CreateAppMutationWithAutoscaleConfigInput.Id, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationWithAutoscaleConfigInput) GetId() string { return v.Id }
+// CheckJobRunsQueryNodeLoggedCertificate includes the requested fields of the GraphQL type LoggedCertificate.
+type CheckJobRunsQueryNodeLoggedCertificate struct {
+	Typename string `json:"__typename"`
+}
 
-// GetOrganizationId returns __CreateAppMutationWithAutoscaleConfigInput.OrganizationId, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationWithAutoscaleConfigInput) GetOrganizationId() string {
-	return v.OrganizationId
+// GetTypename returns CheckJobRunsQueryNodeLoggedCertificate.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeLoggedCertificate) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeMachine includes the requested fields of the GraphQL type Machine.
+type CheckJobRunsQueryNodeMachine struct {
+	Typename string `json:"__typename"`
 }
 
-// GetPreferredRegion returns __CreateAppMutationWithAutoscaleConfigInput.PreferredRegion, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationWithAutoscaleConfigInput) GetPreferredRegion() string {
-	return v.PreferredRegion
+// GetTypename returns CheckJobRunsQueryNodeMachine.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeMachine) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeMachineIP includes the requested fields of the GraphQL type MachineIP.
+type CheckJobRunsQueryNodeMachineIP struct {
+	Typename string `json:"__typename"`
 }
 
-// GetNetwork returns __CreateAppMutationWithAutoscaleConfigInput.Network, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationWithAutoscaleConfigInput) GetNetwork() string { return v.Network }
+// GetTypename returns CheckJobRunsQueryNodeMachineIP.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeMachineIP) GetTypename() string { return v.Typename }
 
-// GetRegions returns __CreateAppMutationWithAutoscaleConfigInput.Regions, and is useful for accessing the field via an interface.
-func (v *__CreateAppMutationWithAutoscaleConfigInput) GetRegions() []AutoscaleRegionConfigInput {
-	return v.Regions
+// CheckJobRunsQueryNodeOrganization includes the requested fields of the GraphQL type Organization.
+type CheckJobRunsQueryNodeOrganization struct {
+	Typename string `json:"__typename"`
 }
 
-// __CreatePostgresClusterInput is used internally by genqlient
-type __CreatePostgresClusterInput struct {
-	Name       string `json:"name"`
-	Orgid      string `json:"orgid"`
-	Region     string `json:"region"`
-	Password   string `json:"password"`
-	Vmsize     string `json:"vmsize"`
-	Volumesize int    `json:"volumesize"`
-	Count      int    `json:"count"`
-	Imageref   string `json:"imageref"`
+// GetTypename returns CheckJobRunsQueryNodeOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeOrganization) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeOrganizationInvitation includes the requested fields of the GraphQL type OrganizationInvitation.
+type CheckJobRunsQueryNodeOrganizationInvitation struct {
+	Typename string `json:"__typename"`
 }
 
-// GetName returns __CreatePostgresClusterInput.Name, and is useful for accessing the field via an interface.
-func (v *__CreatePostgresClusterInput) GetName() string { return v.Name }
+// GetTypename returns CheckJobRunsQueryNodeOrganizationInvitation.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeOrganizationInvitation) GetTypename() string { return v.Typename }
 
-// GetOrgid returns __CreatePostgresClusterInput.Orgid, and is useful for accessing the field via an interface.
-func (v *__CreatePostgresClusterInput) GetOrgid() string { return v.Orgid }
+// CheckJobRunsQueryNodePostgresClusterAttachment includes the requested fields of the GraphQL type PostgresClusterAttachment.
+type CheckJobRunsQueryNodePostgresClusterAttachment struct {
+	Typename string `json:"__typename"`
+}
 
-// GetRegion returns __CreatePostgresClusterInput.Region, and is useful for accessing the field via an interface.
-func (v *__CreatePostgresClusterInput) GetRegion() string { return v.Region }
+// GetTypename returns CheckJobRunsQueryNodePostgresClusterAttachment.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodePostgresClusterAttachment) GetTypename() string { return v.Typename }
 
-// GetPassword returns __CreatePostgresClusterInput.Password, and is useful for accessing the field via an interface.
-func (v *__CreatePostgresClusterInput) GetPassword() string { return v.Password }
+// CheckJobRunsQueryNodeRelease includes the requested fields of the GraphQL type Release.
+type CheckJobRunsQueryNodeRelease struct {
+	Typename string `json:"__typename"`
+}
 
-// GetVmsize returns __CreatePostgresClusterInput.Vmsize, and is useful for accessing the field via an interface.
-func (v *__CreatePostgresClusterInput) GetVmsize() string { return v.Vmsize }
+// GetTypename returns CheckJobRunsQueryNodeRelease.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeRelease) GetTypename() string { return v.Typename }
 
-// GetVolumesize returns __CreatePostgresClusterInput.Volumesize, and is useful for accessing the field via an interface.
-func (v *__CreatePostgresClusterInput) GetVolumesize() int { return v.Volumesize }
+// CheckJobRunsQueryNodeReleaseCommand includes the requested fields of the GraphQL type ReleaseCommand.
+type CheckJobRunsQueryNodeReleaseCommand struct {
+	Typename string `json:"__typename"`
+}
 
-// GetCount returns __CreatePostgresClusterInput.Count, and is useful for accessing the field via an interface.
-func (v *__CreatePostgresClusterInput) GetCount() int { return v.Count }
+// GetTypename returns CheckJobRunsQueryNodeReleaseCommand.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeReleaseCommand) GetTypename() string { return v.Typename }
 
-// GetImageref returns __CreatePostgresClusterInput.Imageref, and is useful for accessing the field via an interface.
-func (v *__CreatePostgresClusterInput) GetImageref() string { return v.Imageref }
+// CheckJobRunsQueryNodeSecret includes the requested fields of the GraphQL type Secret.
+type CheckJobRunsQueryNodeSecret struct {
+	Typename string `json:"__typename"`
+}
 
-// __CreateVolumeInput is used internally by genqlient
-type __CreateVolumeInput struct {
-	App    string `json:"app"`
-	Name   string `json:"name"`
-	Region string `json:"region"`
-	SizeGb int    `json:"sizeGb"`
+// GetTypename returns CheckJobRunsQueryNodeSecret.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeSecret) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeSourceBuild includes the requested fields of the GraphQL type SourceBuild.
+type CheckJobRunsQueryNodeSourceBuild struct {
+	Typename string `json:"__typename"`
 }
 
-// GetApp returns __CreateVolumeInput.App, and is useful for accessing the field via an interface.
-func (v *__CreateVolumeInput) GetApp() string { return v.App }
+// GetTypename returns CheckJobRunsQueryNodeSourceBuild.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeSourceBuild) GetTypename() string { return v.Typename }
 
-// GetName returns __CreateVolumeInput.Name, and is useful for accessing the field via an interface.
-func (v *__CreateVolumeInput) GetName() string { return v.Name }
+// CheckJobRunsQueryNodeTemplateDeployment includes the requested fields of the GraphQL type TemplateDeployment.
+type CheckJobRunsQueryNodeTemplateDeployment struct {
+	Typename string `json:"__typename"`
+}
 
-// GetRegion returns __CreateVolumeInput.Region, and is useful for accessing the field via an interface.
-func (v *__CreateVolumeInput) GetRegion() string { return v.Region }
+// GetTypename returns CheckJobRunsQueryNodeTemplateDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeTemplateDeployment) GetTypename() string { return v.Typename }
 
-// GetSizeGb returns __CreateVolumeInput.SizeGb, and is useful for accessing the field via an interface.
-func (v *__CreateVolumeInput) GetSizeGb() int { return v.SizeGb }
+// CheckJobRunsQueryNodeUser includes the requested fields of the GraphQL type User.
+type CheckJobRunsQueryNodeUser struct {
+	Typename string `json:"__typename"`
+}
 
-// __DeleteAppMutationInput is used internally by genqlient
-type __DeleteAppMutationInput struct {
-	Name string `json:"name"`
+// GetTypename returns CheckJobRunsQueryNodeUser.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeUser) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeVM includes the requested fields of the GraphQL type VM.
+type CheckJobRunsQueryNodeVM struct {
+	Typename string `json:"__typename"`
 }
 
-// GetName returns __DeleteAppMutationInput.Name, and is useful for accessing the field via an interface.
-func (v *__DeleteAppMutationInput) GetName() string { return v.Name }
+// GetTypename returns CheckJobRunsQueryNodeVM.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeVM) GetTypename() string { return v.Typename }
 
-// __DeleteCertificateInput is used internally by genqlient
-type __DeleteCertificateInput struct {
-	App      string `json:"app"`
-	Hostname string `json:"hostname"`
+// CheckJobRunsQueryNodeVolume includes the requested fields of the GraphQL type Volume.
+type CheckJobRunsQueryNodeVolume struct {
+	Typename string `json:"__typename"`
 }
 
-// GetApp returns __DeleteCertificateInput.App, and is useful for accessing the field via an interface.
-func (v *__DeleteCertificateInput) GetApp() string { return v.App }
+// GetTypename returns CheckJobRunsQueryNodeVolume.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeVolume) GetTypename() string { return v.Typename }
 
-// GetHostname returns __DeleteCertificateInput.Hostname, and is useful for accessing the field via an interface.
-func (v *__DeleteCertificateInput) GetHostname() string { return v.Hostname }
+// CheckJobRunsQueryNodeVolumeSnapshot includes the requested fields of the GraphQL type VolumeSnapshot.
+type CheckJobRunsQueryNodeVolumeSnapshot struct {
+	Typename string `json:"__typename"`
+}
 
-// __DeleteVolumeInput is used internally by genqlient
-type __DeleteVolumeInput struct {
-	Volume string `json:"volume"`
+// GetTypename returns CheckJobRunsQueryNodeVolumeSnapshot.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeVolumeSnapshot) GetTypename() string { return v.Typename }
+
+// CheckJobRunsQueryNodeWireGuardPeer includes the requested fields of the GraphQL type WireGuardPeer.
+type CheckJobRunsQueryNodeWireGuardPeer struct {
+	Typename string `json:"__typename"`
 }
 
-// GetVolume returns __DeleteVolumeInput.Volume, and is useful for accessing the field via an interface.
-func (v *__DeleteVolumeInput) GetVolume() string { return v.Volume }
+// GetTypename returns CheckJobRunsQueryNodeWireGuardPeer.Typename, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryNodeWireGuardPeer) GetTypename() string { return v.Typename }
 
-// __GetCertificateInput is used internally by genqlient
-type __GetCertificateInput struct {
-	App      string `json:"app"`
-	Hostname string `json:"hostname"`
+// CheckJobRunsQueryResponse is returned by CheckJobRunsQuery on success.
+type CheckJobRunsQueryResponse struct {
+	// Fetches an object given its ID.
+	Node CheckJobRunsQueryNode `json:"-"`
 }
 
-// GetApp returns __GetCertificateInput.App, and is useful for accessing the field via an interface.
-func (v *__GetCertificateInput) GetApp() string { return v.App }
+// GetNode returns CheckJobRunsQueryResponse.Node, and is useful for accessing the field via an interface.
+func (v *CheckJobRunsQueryResponse) GetNode() CheckJobRunsQueryNode { return v.Node }
 
-// GetHostname returns __GetCertificateInput.Hostname, and is useful for accessing the field via an interface.
-func (v *__GetCertificateInput) GetHostname() string { return v.Hostname }
+func (v *CheckJobRunsQueryResponse) UnmarshalJSON(b []byte) error {
 
-// __GetFullAppInput is used internally by genqlient
-type __GetFullAppInput struct {
-	Name string `json:"name"`
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CheckJobRunsQueryResponse
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CheckJobRunsQueryResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalCheckJobRunsQueryNode(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal CheckJobRunsQueryResponse.Node: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetName returns __GetFullAppInput.Name, and is useful for accessing the field via an interface.
-func (v *__GetFullAppInput) GetName() string { return v.Name }
+type __premarshalCheckJobRunsQueryResponse struct {
+	Node json.RawMessage `json:"node"`
+}
 
-// __IpAddressQueryInput is used internally by genqlient
-type __IpAddressQueryInput struct {
-	App  string `json:"app"`
-	Addr string `json:"addr"`
+func (v *CheckJobRunsQueryResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetApp returns __IpAddressQueryInput.App, and is useful for accessing the field via an interface.
-func (v *__IpAddressQueryInput) GetApp() string { return v.App }
+func (v *CheckJobRunsQueryResponse) __premarshalJSON() (*__premarshalCheckJobRunsQueryResponse, error) {
+	var retval __premarshalCheckJobRunsQueryResponse
 
-// GetAddr returns __IpAddressQueryInput.Addr, and is useful for accessing the field via an interface.
-func (v *__IpAddressQueryInput) GetAddr() string { return v.Addr }
+	{
 
-// __ReleaseIpAddressInput is used internally by genqlient
-type __ReleaseIpAddressInput struct {
-	AddressId string `json:"addressId"`
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalCheckJobRunsQueryNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal CheckJobRunsQueryResponse.Node: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// GetAddressId returns __ReleaseIpAddressInput.AddressId, and is useful for accessing the field via an interface.
-func (v *__ReleaseIpAddressInput) GetAddressId() string { return v.AddressId }
+// CheckLocationsQueryCheckLocationsCheckLocation includes the requested fields of the GraphQL type CheckLocation.
+// The GraphQL type's documentation follows.
+//
+// check location
+type CheckLocationsQueryCheckLocationsCheckLocation struct {
+	Name     string `json:"name"`
+	Title    string `json:"title"`
+	Locality string `json:"locality"`
+	State    string `json:"state"`
+	Country  string `json:"country"`
+}
 
-// __RemoveWireguardPeerInput is used internally by genqlient
-type __RemoveWireguardPeerInput struct {
-	Input RemoveWireGuardPeerInput `json:"input"`
+// GetName returns CheckLocationsQueryCheckLocationsCheckLocation.Name, and is useful for accessing the field via an interface.
+func (v *CheckLocationsQueryCheckLocationsCheckLocation) GetName() string { return v.Name }
+
+// GetTitle returns CheckLocationsQueryCheckLocationsCheckLocation.Title, and is useful for accessing the field via an interface.
+func (v *CheckLocationsQueryCheckLocationsCheckLocation) GetTitle() string { return v.Title }
+
+// GetLocality returns CheckLocationsQueryCheckLocationsCheckLocation.Locality, and is useful for accessing the field via an interface.
+func (v *CheckLocationsQueryCheckLocationsCheckLocation) GetLocality() string { return v.Locality }
+
+// GetState returns CheckLocationsQueryCheckLocationsCheckLocation.State, and is useful for accessing the field via an interface.
+func (v *CheckLocationsQueryCheckLocationsCheckLocation) GetState() string { return v.State }
+
+// GetCountry returns CheckLocationsQueryCheckLocationsCheckLocation.Country, and is useful for accessing the field via an interface.
+func (v *CheckLocationsQueryCheckLocationsCheckLocation) GetCountry() string { return v.Country }
+
+// CheckLocationsQueryResponse is returned by CheckLocationsQuery on success.
+type CheckLocationsQueryResponse struct {
+	CheckLocations []CheckLocationsQueryCheckLocationsCheckLocation `json:"checkLocations"`
+}
+
+// GetCheckLocations returns CheckLocationsQueryResponse.CheckLocations, and is useful for accessing the field via an interface.
+func (v *CheckLocationsQueryResponse) GetCheckLocations() []CheckLocationsQueryCheckLocationsCheckLocation {
+	return v.CheckLocations
+}
+
+// ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayload includes the requested fields of the GraphQL type ConfigureRegionsPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of ConfigureRegions
+type ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayload struct {
+	Regions       []ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadRegionsRegion       `json:"regions"`
+	BackupRegions []ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadBackupRegionsRegion `json:"backupRegions"`
+}
+
+// GetRegions returns ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayload.Regions, and is useful for accessing the field via an interface.
+func (v *ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayload) GetRegions() []ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadRegionsRegion {
+	return v.Regions
+}
+
+// GetBackupRegions returns ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayload.BackupRegions, and is useful for accessing the field via an interface.
+func (v *ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayload) GetBackupRegions() []ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadBackupRegionsRegion {
+	return v.BackupRegions
+}
+
+// ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadBackupRegionsRegion includes the requested fields of the GraphQL type Region.
+type ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadBackupRegionsRegion struct {
+	// The IATA airport code for this region
+	Code string `json:"code"`
+}
+
+// GetCode returns ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadBackupRegionsRegion.Code, and is useful for accessing the field via an interface.
+func (v *ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadBackupRegionsRegion) GetCode() string {
+	return v.Code
+}
+
+// ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadRegionsRegion includes the requested fields of the GraphQL type Region.
+type ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadRegionsRegion struct {
+	// The IATA airport code for this region
+	Code string `json:"code"`
+}
+
+// GetCode returns ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadRegionsRegion.Code, and is useful for accessing the field via an interface.
+func (v *ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayloadRegionsRegion) GetCode() string {
+	return v.Code
+}
+
+// ConfigureRegionsMutationResponse is returned by ConfigureRegionsMutation on success.
+type ConfigureRegionsMutationResponse struct {
+	ConfigureRegions ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayload `json:"configureRegions"`
+}
+
+// GetConfigureRegions returns ConfigureRegionsMutationResponse.ConfigureRegions, and is useful for accessing the field via an interface.
+func (v *ConfigureRegionsMutationResponse) GetConfigureRegions() ConfigureRegionsMutationConfigureRegionsConfigureRegionsPayload {
+	return v.ConfigureRegions
+}
+
+// CreateAppMutationCreateAppCreateAppPayload includes the requested fields of the GraphQL type CreateAppPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateApp
+type CreateAppMutationCreateAppCreateAppPayload struct {
+	App CreateAppMutationCreateAppCreateAppPayloadApp `json:"app"`
+}
+
+// GetApp returns CreateAppMutationCreateAppCreateAppPayload.App, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationCreateAppCreateAppPayload) GetApp() CreateAppMutationCreateAppCreateAppPayloadApp {
+	return v.App
+}
+
+// CreateAppMutationCreateAppCreateAppPayloadApp includes the requested fields of the GraphQL type App.
+type CreateAppMutationCreateAppCreateAppPayloadApp struct {
+	// The unique application name
+	Name string `json:"name"`
+	// Application status
+	Status      string                                                                    `json:"status"`
+	Autoscaling CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig `json:"autoscaling"`
+	// Organization that owns this app
+	Organization CreateAppMutationCreateAppCreateAppPayloadAppOrganization `json:"organization"`
+	Network      string                                                    `json:"network"`
+}
+
+// GetName returns CreateAppMutationCreateAppCreateAppPayloadApp.Name, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetName() string { return v.Name }
+
+// GetStatus returns CreateAppMutationCreateAppCreateAppPayloadApp.Status, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetStatus() string { return v.Status }
+
+// GetAutoscaling returns CreateAppMutationCreateAppCreateAppPayloadApp.Autoscaling, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetAutoscaling() CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig {
+	return v.Autoscaling
+}
+
+// GetOrganization returns CreateAppMutationCreateAppCreateAppPayloadApp.Organization, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetOrganization() CreateAppMutationCreateAppCreateAppPayloadAppOrganization {
+	return v.Organization
+}
+
+// GetNetwork returns CreateAppMutationCreateAppCreateAppPayloadApp.Network, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationCreateAppCreateAppPayloadApp) GetNetwork() string { return v.Network }
+
+// CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
+type CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig struct {
+	PreferredRegion string `json:"preferredRegion"`
+}
+
+// GetPreferredRegion returns CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig.PreferredRegion, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig) GetPreferredRegion() string {
+	return v.PreferredRegion
+}
+
+// CreateAppMutationCreateAppCreateAppPayloadAppOrganization includes the requested fields of the GraphQL type Organization.
+type CreateAppMutationCreateAppCreateAppPayloadAppOrganization struct {
+	Id string `json:"id"`
+	// Unique organization slug
+	Slug string `json:"slug"`
+}
+
+// GetId returns CreateAppMutationCreateAppCreateAppPayloadAppOrganization.Id, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationCreateAppCreateAppPayloadAppOrganization) GetId() string { return v.Id }
+
+// GetSlug returns CreateAppMutationCreateAppCreateAppPayloadAppOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationCreateAppCreateAppPayloadAppOrganization) GetSlug() string { return v.Slug }
+
+// CreateAppMutationResponse is returned by CreateAppMutation on success.
+type CreateAppMutationResponse struct {
+	CreateApp CreateAppMutationCreateAppCreateAppPayload `json:"createApp"`
+}
+
+// GetCreateApp returns CreateAppMutationResponse.CreateApp, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationResponse) GetCreateApp() CreateAppMutationCreateAppCreateAppPayload {
+	return v.CreateApp
+}
+
+// CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload includes the requested fields of the GraphQL type CreateAppPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateApp
+type CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload struct {
+	App CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp `json:"app"`
+}
+
+// GetApp returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload.App, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload) GetApp() CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp {
+	return v.App
+}
+
+// CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp includes the requested fields of the GraphQL type App.
+type CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp struct {
+	// The unique application name
+	Name string `json:"name"`
+	// Application status
+	Status      string                                                                                       `json:"status"`
+	Autoscaling CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig `json:"autoscaling"`
+	// Organization that owns this app
+	Organization CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization `json:"organization"`
+	Network      string                                                                       `json:"network"`
+}
+
+// GetName returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Name, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetName() string {
+	return v.Name
+}
+
+// GetStatus returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Status, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetStatus() string {
+	return v.Status
+}
+
+// GetAutoscaling returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Autoscaling, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetAutoscaling() CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig {
+	return v.Autoscaling
+}
+
+// GetOrganization returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Organization, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetOrganization() CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization {
+	return v.Organization
+}
+
+// GetNetwork returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp.Network, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadApp) GetNetwork() string {
+	return v.Network
+}
+
+// CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
+type CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig struct {
+	PreferredRegion string `json:"preferredRegion"`
+}
+
+// GetPreferredRegion returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig.PreferredRegion, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppAutoscalingAutoscalingConfig) GetPreferredRegion() string {
+	return v.PreferredRegion
+}
+
+// CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization includes the requested fields of the GraphQL type Organization.
+type CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization struct {
+	Id string `json:"id"`
+	// Unique organization slug
+	Slug string `json:"slug"`
+}
+
+// GetId returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization.Id, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization) GetId() string {
+	return v.Id
+}
+
+// GetSlug returns CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayloadAppOrganization) GetSlug() string {
+	return v.Slug
+}
+
+// CreateAppMutationWithAutoscaleConfigResponse is returned by CreateAppMutationWithAutoscaleConfig on success.
+type CreateAppMutationWithAutoscaleConfigResponse struct {
+	CreateApp             CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload                         `json:"createApp"`
+	UpdateAutoscaleConfig CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload `json:"updateAutoscaleConfig"`
+}
+
+// GetCreateApp returns CreateAppMutationWithAutoscaleConfigResponse.CreateApp, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigResponse) GetCreateApp() CreateAppMutationWithAutoscaleConfigCreateAppCreateAppPayload {
+	return v.CreateApp
+}
+
+// GetUpdateAutoscaleConfig returns CreateAppMutationWithAutoscaleConfigResponse.UpdateAutoscaleConfig, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigResponse) GetUpdateAutoscaleConfig() CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload {
+	return v.UpdateAutoscaleConfig
+}
+
+// CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload includes the requested fields of the GraphQL type UpdateAutoscaleConfigPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of UpdateAutoscaleConfig
+type CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload struct {
+	App CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp `json:"app"`
+}
+
+// GetApp returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload.App, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayload) GetApp() CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp {
+	return v.App
+}
+
+// CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp includes the requested fields of the GraphQL type App.
+type CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp struct {
+	// Application status
+	Status      string                                                                                                               `json:"status"`
+	Autoscaling CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig `json:"autoscaling"`
+}
+
+// GetStatus returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp.Status, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp) GetStatus() string {
+	return v.Status
+}
+
+// GetAutoscaling returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp.Autoscaling, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp) GetAutoscaling() CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig {
+	return v.Autoscaling
+}
+
+// CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
+type CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig struct {
+	Regions []CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig `json:"regions"`
+}
+
+// GetRegions returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig.Regions, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig) GetRegions() []CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig {
+	return v.Regions
+}
+
+// CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig includes the requested fields of the GraphQL type AutoscaleRegionConfig.
+type CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig struct {
+	// The region code
+	Code string `json:"code"`
+}
+
+// GetCode returns CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig.Code, and is useful for accessing the field via an interface.
+func (v *CreateAppMutationWithAutoscaleConfigUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) GetCode() string {
+	return v.Code
+}
+
+// CreateCheckJobMutationCreateCheckJobCreateCheckJobPayload includes the requested fields of the GraphQL type CreateCheckJobPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateCheckJob
+type CreateCheckJobMutationCreateCheckJobCreateCheckJobPayload struct {
+	CheckJob CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob `json:"checkJob"`
+}
+
+// GetCheckJob returns CreateCheckJobMutationCreateCheckJobCreateCheckJobPayload.CheckJob, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobMutationCreateCheckJobCreateCheckJobPayload) GetCheckJob() CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob {
+	return v.CheckJob
+}
+
+// CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob includes the requested fields of the GraphQL type CheckJob.
+// The GraphQL type's documentation follows.
+//
+// check job
+type CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob struct {
+	CheckJobFragment `json:"-"`
+}
+
+// GetId returns CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob.Id, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob) GetId() string {
+	return v.CheckJobFragment.Id
+}
+
+// GetUrl returns CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob.Url, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob) GetUrl() string {
+	return v.CheckJobFragment.Url
+}
+
+// GetSchedule returns CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob.Schedule, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob) GetSchedule() string {
+	return v.CheckJobFragment.Schedule
+}
+
+// GetHttpOptions returns CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob.HttpOptions, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob) GetHttpOptions() CheckJobFragmentHttpOptionsCheckJobHTTPOptions {
+	return v.CheckJobFragment.HttpOptions
+}
+
+// GetLocations returns CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob.Locations, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob) GetLocations() CheckJobFragmentLocationsCheckLocationConnection {
+	return v.CheckJobFragment.Locations
+}
+
+func (v *CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CheckJobFragment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob struct {
+	Id string `json:"id"`
+
+	Url string `json:"url"`
+
+	Schedule string `json:"schedule"`
+
+	HttpOptions CheckJobFragmentHttpOptionsCheckJobHTTPOptions `json:"httpOptions"`
+
+	Locations CheckJobFragmentLocationsCheckLocationConnection `json:"locations"`
+}
+
+func (v *CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob) __premarshalJSON() (*__premarshalCreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob, error) {
+	var retval __premarshalCreateCheckJobMutationCreateCheckJobCreateCheckJobPayloadCheckJob
+
+	retval.Id = v.CheckJobFragment.Id
+	retval.Url = v.CheckJobFragment.Url
+	retval.Schedule = v.CheckJobFragment.Schedule
+	retval.HttpOptions = v.CheckJobFragment.HttpOptions
+	retval.Locations = v.CheckJobFragment.Locations
+	return &retval, nil
+}
+
+// CreateCheckJobMutationResponse is returned by CreateCheckJobMutation on success.
+type CreateCheckJobMutationResponse struct {
+	CreateCheckJob CreateCheckJobMutationCreateCheckJobCreateCheckJobPayload `json:"createCheckJob"`
+}
+
+// GetCreateCheckJob returns CreateCheckJobMutationResponse.CreateCheckJob, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobMutationResponse) GetCreateCheckJob() CreateCheckJobMutationCreateCheckJobCreateCheckJobPayload {
+	return v.CreateCheckJob
+}
+
+// CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayload includes the requested fields of the GraphQL type CreateCheckJobRunPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateCheckJobRun
+type CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayload struct {
+	CheckJobRun CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayloadCheckJobRun `json:"checkJobRun"`
+}
+
+// GetCheckJobRun returns CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayload.CheckJobRun, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayload) GetCheckJobRun() CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayloadCheckJobRun {
+	return v.CheckJobRun
+}
+
+// CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayloadCheckJobRun includes the requested fields of the GraphQL type CheckJobRun.
+// The GraphQL type's documentation follows.
+//
+// check job run
+type CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayloadCheckJobRun struct {
+	Id    string `json:"id"`
+	State string `json:"state"`
+}
+
+// GetId returns CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayloadCheckJobRun.Id, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayloadCheckJobRun) GetId() string {
+	return v.Id
+}
+
+// GetState returns CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayloadCheckJobRun.State, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayloadCheckJobRun) GetState() string {
+	return v.State
+}
+
+// CreateCheckJobRunMutationResponse is returned by CreateCheckJobRunMutation on success.
+type CreateCheckJobRunMutationResponse struct {
+	CreateCheckJobRun CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayload `json:"createCheckJobRun"`
+}
+
+// GetCreateCheckJobRun returns CreateCheckJobRunMutationResponse.CreateCheckJobRun, and is useful for accessing the field via an interface.
+func (v *CreateCheckJobRunMutationResponse) GetCreateCheckJobRun() CreateCheckJobRunMutationCreateCheckJobRunCreateCheckJobRunPayload {
+	return v.CreateCheckJobRun
+}
+
+// CreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenPayload includes the requested fields of the GraphQL type CreateDelegatedWireGuardTokenPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateDelegatedWireGuardToken
+type CreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenPayload struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns CreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenPayload.Token, and is useful for accessing the field via an interface.
+func (v *CreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenPayload) GetToken() string {
+	return v.Token
+}
+
+// CreateDelegatedWireGuardTokenResponse is returned by CreateDelegatedWireGuardToken on success.
+type CreateDelegatedWireGuardTokenResponse struct {
+	CreateDelegatedWireGuardToken CreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenPayload `json:"createDelegatedWireGuardToken"`
+}
+
+// GetCreateDelegatedWireGuardToken returns CreateDelegatedWireGuardTokenResponse.CreateDelegatedWireGuardToken, and is useful for accessing the field via an interface.
+func (v *CreateDelegatedWireGuardTokenResponse) GetCreateDelegatedWireGuardToken() CreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenCreateDelegatedWireGuardTokenPayload {
+	return v.CreateDelegatedWireGuardToken
+}
+
+// CreateDoctorReportCreateDoctorReportCreateDoctorReportPayload includes the requested fields of the GraphQL type CreateDoctorReportPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateDoctorReport
+type CreateDoctorReportCreateDoctorReportCreateDoctorReportPayload struct {
+	ReportId string `json:"reportId"`
+}
+
+// GetReportId returns CreateDoctorReportCreateDoctorReportCreateDoctorReportPayload.ReportId, and is useful for accessing the field via an interface.
+func (v *CreateDoctorReportCreateDoctorReportCreateDoctorReportPayload) GetReportId() string {
+	return v.ReportId
+}
+
+// CreateDoctorReportResponse is returned by CreateDoctorReport on success.
+type CreateDoctorReportResponse struct {
+	CreateDoctorReport CreateDoctorReportCreateDoctorReportCreateDoctorReportPayload `json:"createDoctorReport"`
+}
+
+// GetCreateDoctorReport returns CreateDoctorReportResponse.CreateDoctorReport, and is useful for accessing the field via an interface.
+func (v *CreateDoctorReportResponse) GetCreateDoctorReport() CreateDoctorReportCreateDoctorReportCreateDoctorReportPayload {
+	return v.CreateDoctorReport
+}
+
+// CreateOrganizationCreateOrganizationCreateOrganizationPayload includes the requested fields of the GraphQL type CreateOrganizationPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateOrganization
+type CreateOrganizationCreateOrganizationCreateOrganizationPayload struct {
+	Organization CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization `json:"organization"`
+}
+
+// GetOrganization returns CreateOrganizationCreateOrganizationCreateOrganizationPayload.Organization, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationCreateOrganizationCreateOrganizationPayload) GetOrganization() CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization {
+	return v.Organization
+}
+
+// CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization includes the requested fields of the GraphQL type Organization.
+type CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization struct {
+	Id string `json:"id"`
+	// Unique organization slug
+	Slug string `json:"slug"`
+	// Organization name
+	Name string `json:"name"`
+	// The type of organization
+	Type OrganizationType `json:"type"`
+}
+
+// GetId returns CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization.Id, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization) GetId() string {
+	return v.Id
+}
+
+// GetSlug returns CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization) GetSlug() string {
+	return v.Slug
+}
+
+// GetName returns CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization.Name, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization) GetName() string {
+	return v.Name
+}
+
+// GetType returns CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization.Type, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationCreateOrganizationCreateOrganizationPayloadOrganization) GetType() OrganizationType {
+	return v.Type
+}
+
+// CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayload includes the requested fields of the GraphQL type CreateOrganizationInvitationPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateOrganizationInvitation
+type CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayload struct {
+	Invitation CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation `json:"invitation"`
+}
+
+// GetInvitation returns CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayload.Invitation, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayload) GetInvitation() CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation {
+	return v.Invitation
+}
+
+// CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation includes the requested fields of the GraphQL type OrganizationInvitation.
+type CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation struct {
+	Id       string `json:"id"`
+	Email    string `json:"email"`
+	Redeemed bool   `json:"redeemed"`
+}
+
+// GetId returns CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation.Id, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation) GetId() string {
+	return v.Id
+}
+
+// GetEmail returns CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation.Email, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation) GetEmail() string {
+	return v.Email
+}
+
+// GetRedeemed returns CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation.Redeemed, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayloadInvitationOrganizationInvitation) GetRedeemed() bool {
+	return v.Redeemed
+}
+
+// CreateOrganizationInvitationResponse is returned by CreateOrganizationInvitation on success.
+type CreateOrganizationInvitationResponse struct {
+	CreateOrganizationInvitation CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayload `json:"createOrganizationInvitation"`
+}
+
+// GetCreateOrganizationInvitation returns CreateOrganizationInvitationResponse.CreateOrganizationInvitation, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationInvitationResponse) GetCreateOrganizationInvitation() CreateOrganizationInvitationCreateOrganizationInvitationCreateOrganizationInvitationPayload {
+	return v.CreateOrganizationInvitation
+}
+
+// CreateOrganizationResponse is returned by CreateOrganization on success.
+type CreateOrganizationResponse struct {
+	CreateOrganization CreateOrganizationCreateOrganizationCreateOrganizationPayload `json:"createOrganization"`
+}
+
+// GetCreateOrganization returns CreateOrganizationResponse.CreateOrganization, and is useful for accessing the field via an interface.
+func (v *CreateOrganizationResponse) GetCreateOrganization() CreateOrganizationCreateOrganizationCreateOrganizationPayload {
+	return v.CreateOrganization
+}
+
+// CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload includes the requested fields of the GraphQL type CreatePostgresClusterPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreatePostgresCluster
+type CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload struct {
+	App      CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp `json:"app"`
+	Username string                                                                    `json:"username"`
+	Password string                                                                    `json:"password"`
+}
+
+// GetApp returns CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload.App, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload) GetApp() CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp {
+	return v.App
+}
+
+// GetUsername returns CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload.Username, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload) GetUsername() string {
+	return v.Username
+}
+
+// GetPassword returns CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload.Password, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload) GetPassword() string {
+	return v.Password
+}
+
+// CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp includes the requested fields of the GraphQL type App.
+type CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp struct {
+	// The unique application name
+	Name string `json:"name"`
+}
+
+// GetName returns CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp.Name, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayloadApp) GetName() string {
+	return v.Name
+}
+
+// CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayload includes the requested fields of the GraphQL type CreatePostgresClusterDatabasePayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreatePostgresClusterDatabase
+type CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayload struct {
+	Database CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayloadDatabasePostgresClusterDatabase `json:"database"`
+}
+
+// GetDatabase returns CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayload.Database, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayload) GetDatabase() CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayloadDatabasePostgresClusterDatabase {
+	return v.Database
+}
+
+// CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayloadDatabasePostgresClusterDatabase includes the requested fields of the GraphQL type PostgresClusterDatabase.
+type CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayloadDatabasePostgresClusterDatabase struct {
+	Name string `json:"name"`
+}
+
+// GetName returns CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayloadDatabasePostgresClusterDatabase.Name, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayloadDatabasePostgresClusterDatabase) GetName() string {
+	return v.Name
+}
+
+// CreatePostgresClusterDatabaseMutationResponse is returned by CreatePostgresClusterDatabaseMutation on success.
+type CreatePostgresClusterDatabaseMutationResponse struct {
+	CreatePostgresClusterDatabase CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayload `json:"createPostgresClusterDatabase"`
+}
+
+// GetCreatePostgresClusterDatabase returns CreatePostgresClusterDatabaseMutationResponse.CreatePostgresClusterDatabase, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterDatabaseMutationResponse) GetCreatePostgresClusterDatabase() CreatePostgresClusterDatabaseMutationCreatePostgresClusterDatabaseCreatePostgresClusterDatabasePayload {
+	return v.CreatePostgresClusterDatabase
+}
+
+// CreatePostgresClusterResponse is returned by CreatePostgresCluster on success.
+type CreatePostgresClusterResponse struct {
+	CreatePostgresCluster CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload `json:"createPostgresCluster"`
+}
+
+// GetCreatePostgresCluster returns CreatePostgresClusterResponse.CreatePostgresCluster, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterResponse) GetCreatePostgresCluster() CreatePostgresClusterCreatePostgresClusterCreatePostgresClusterPayload {
+	return v.CreatePostgresCluster
+}
+
+// CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayload includes the requested fields of the GraphQL type CreatePostgresClusterUserPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreatePostgresClusterUser
+type CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayload struct {
+	User CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayloadUserPostgresClusterUser `json:"user"`
+}
+
+// GetUser returns CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayload.User, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayload) GetUser() CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayloadUserPostgresClusterUser {
+	return v.User
+}
+
+// CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayloadUserPostgresClusterUser includes the requested fields of the GraphQL type PostgresClusterUser.
+type CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayloadUserPostgresClusterUser struct {
+	Username    string `json:"username"`
+	IsSuperuser bool   `json:"isSuperuser"`
+}
+
+// GetUsername returns CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayloadUserPostgresClusterUser.Username, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayloadUserPostgresClusterUser) GetUsername() string {
+	return v.Username
+}
+
+// GetIsSuperuser returns CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayloadUserPostgresClusterUser.IsSuperuser, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayloadUserPostgresClusterUser) GetIsSuperuser() bool {
+	return v.IsSuperuser
+}
+
+// CreatePostgresClusterUserMutationResponse is returned by CreatePostgresClusterUserMutation on success.
+type CreatePostgresClusterUserMutationResponse struct {
+	CreatePostgresClusterUser CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayload `json:"createPostgresClusterUser"`
+}
+
+// GetCreatePostgresClusterUser returns CreatePostgresClusterUserMutationResponse.CreatePostgresClusterUser, and is useful for accessing the field via an interface.
+func (v *CreatePostgresClusterUserMutationResponse) GetCreatePostgresClusterUser() CreatePostgresClusterUserMutationCreatePostgresClusterUserCreatePostgresClusterUserPayload {
+	return v.CreatePostgresClusterUser
+}
+
+// CreateVolumeCreateVolumeCreateVolumePayload includes the requested fields of the GraphQL type CreateVolumePayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateVolume
+type CreateVolumeCreateVolumeCreateVolumePayload struct {
+	Volume CreateVolumeCreateVolumeCreateVolumePayloadVolume `json:"volume"`
+}
+
+// GetVolume returns CreateVolumeCreateVolumeCreateVolumePayload.Volume, and is useful for accessing the field via an interface.
+func (v *CreateVolumeCreateVolumeCreateVolumePayload) GetVolume() CreateVolumeCreateVolumeCreateVolumePayloadVolume {
+	return v.Volume
+}
+
+// CreateVolumeCreateVolumeCreateVolumePayloadVolume includes the requested fields of the GraphQL type Volume.
+type CreateVolumeCreateVolumeCreateVolumePayloadVolume struct {
+	Name       string `json:"name"`
+	Region     string `json:"region"`
+	Id         string `json:"id"`
+	InternalId string `json:"internalId"`
+	SizeGb     int    `json:"sizeGb"`
+}
+
+// GetName returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.Name, and is useful for accessing the field via an interface.
+func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetName() string { return v.Name }
+
+// GetRegion returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.Region, and is useful for accessing the field via an interface.
+func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetRegion() string { return v.Region }
+
+// GetId returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.Id, and is useful for accessing the field via an interface.
+func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetId() string { return v.Id }
+
+// GetInternalId returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.InternalId, and is useful for accessing the field via an interface.
+func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetInternalId() string {
+	return v.InternalId
+}
+
+// GetSizeGb returns CreateVolumeCreateVolumeCreateVolumePayloadVolume.SizeGb, and is useful for accessing the field via an interface.
+func (v *CreateVolumeCreateVolumeCreateVolumePayloadVolume) GetSizeGb() int { return v.SizeGb }
+
+// CreateVolumeResponse is returned by CreateVolume on success.
+type CreateVolumeResponse struct {
+	CreateVolume CreateVolumeCreateVolumeCreateVolumePayload `json:"createVolume"`
+}
+
+// GetCreateVolume returns CreateVolumeResponse.CreateVolume, and is useful for accessing the field via an interface.
+func (v *CreateVolumeResponse) GetCreateVolume() CreateVolumeCreateVolumeCreateVolumePayload {
+	return v.CreateVolume
+}
+
+// CurrentReleaseQueryApp includes the requested fields of the GraphQL type App.
+type CurrentReleaseQueryApp struct {
+	// The latest release of this applicaion
+	CurrentRelease CurrentReleaseQueryAppCurrentRelease `json:"currentRelease"`
+}
+
+// GetCurrentRelease returns CurrentReleaseQueryApp.CurrentRelease, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryApp) GetCurrentRelease() CurrentReleaseQueryAppCurrentRelease {
+	return v.CurrentRelease
+}
+
+// CurrentReleaseQueryAppCurrentRelease includes the requested fields of the GraphQL type Release.
+type CurrentReleaseQueryAppCurrentRelease struct {
+	ReleaseFragment `json:"-"`
+	// Docker image
+	Image CurrentReleaseQueryAppCurrentReleaseImage `json:"image"`
+}
+
+// GetImage returns CurrentReleaseQueryAppCurrentRelease.Image, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetImage() CurrentReleaseQueryAppCurrentReleaseImage {
+	return v.Image
+}
+
+// GetId returns CurrentReleaseQueryAppCurrentRelease.Id, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetId() string { return v.ReleaseFragment.Id }
+
+// GetVersion returns CurrentReleaseQueryAppCurrentRelease.Version, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetVersion() int { return v.ReleaseFragment.Version }
+
+// GetStatus returns CurrentReleaseQueryAppCurrentRelease.Status, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetStatus() string { return v.ReleaseFragment.Status }
+
+// GetReason returns CurrentReleaseQueryAppCurrentRelease.Reason, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetReason() string { return v.ReleaseFragment.Reason }
+
+// GetDescription returns CurrentReleaseQueryAppCurrentRelease.Description, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetDescription() string {
+	return v.ReleaseFragment.Description
+}
+
+// GetStable returns CurrentReleaseQueryAppCurrentRelease.Stable, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetStable() bool { return v.ReleaseFragment.Stable }
+
+// GetImageRef returns CurrentReleaseQueryAppCurrentRelease.ImageRef, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetImageRef() string {
+	return v.ReleaseFragment.ImageRef
+}
+
+// GetUser returns CurrentReleaseQueryAppCurrentRelease.User, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetUser() ReleaseFragmentUser {
+	return v.ReleaseFragment.User
+}
+
+// GetCreatedAt returns CurrentReleaseQueryAppCurrentRelease.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentRelease) GetCreatedAt() time.Time {
+	return v.ReleaseFragment.CreatedAt
+}
+
+func (v *CurrentReleaseQueryAppCurrentRelease) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CurrentReleaseQueryAppCurrentRelease
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CurrentReleaseQueryAppCurrentRelease = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReleaseFragment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCurrentReleaseQueryAppCurrentRelease struct {
+	Image CurrentReleaseQueryAppCurrentReleaseImage `json:"image"`
+
+	Id string `json:"id"`
+
+	Version int `json:"version"`
+
+	Status string `json:"status"`
+
+	Reason string `json:"reason"`
+
+	Description string `json:"description"`
+
+	Stable bool `json:"stable"`
+
+	ImageRef string `json:"imageRef"`
+
+	User ReleaseFragmentUser `json:"user"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *CurrentReleaseQueryAppCurrentRelease) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CurrentReleaseQueryAppCurrentRelease) __premarshalJSON() (*__premarshalCurrentReleaseQueryAppCurrentRelease, error) {
+	var retval __premarshalCurrentReleaseQueryAppCurrentRelease
+
+	retval.Image = v.Image
+	retval.Id = v.ReleaseFragment.Id
+	retval.Version = v.ReleaseFragment.Version
+	retval.Status = v.ReleaseFragment.Status
+	retval.Reason = v.ReleaseFragment.Reason
+	retval.Description = v.ReleaseFragment.Description
+	retval.Stable = v.ReleaseFragment.Stable
+	retval.ImageRef = v.ReleaseFragment.ImageRef
+	retval.User = v.ReleaseFragment.User
+	retval.CreatedAt = v.ReleaseFragment.CreatedAt
+	return &retval, nil
+}
+
+// CurrentReleaseQueryAppCurrentReleaseImage includes the requested fields of the GraphQL type Image.
+type CurrentReleaseQueryAppCurrentReleaseImage struct {
+	Registry   string `json:"registry"`
+	Repository string `json:"repository"`
+	Tag        string `json:"tag"`
+	Digest     string `json:"digest"`
+}
+
+// GetRegistry returns CurrentReleaseQueryAppCurrentReleaseImage.Registry, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentReleaseImage) GetRegistry() string { return v.Registry }
+
+// GetRepository returns CurrentReleaseQueryAppCurrentReleaseImage.Repository, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentReleaseImage) GetRepository() string { return v.Repository }
+
+// GetTag returns CurrentReleaseQueryAppCurrentReleaseImage.Tag, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentReleaseImage) GetTag() string { return v.Tag }
+
+// GetDigest returns CurrentReleaseQueryAppCurrentReleaseImage.Digest, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryAppCurrentReleaseImage) GetDigest() string { return v.Digest }
+
+// CurrentReleaseQueryResponse is returned by CurrentReleaseQuery on success.
+type CurrentReleaseQueryResponse struct {
+	// Find an app by name
+	App CurrentReleaseQueryApp `json:"app"`
+}
+
+// GetApp returns CurrentReleaseQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *CurrentReleaseQueryResponse) GetApp() CurrentReleaseQueryApp { return v.App }
+
+// DelegatedWireGuardTokensQueryOrganization includes the requested fields of the GraphQL type Organization.
+type DelegatedWireGuardTokensQueryOrganization struct {
+	DelegatedWireGuardTokens DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnection `json:"delegatedWireGuardTokens"`
+}
+
+// GetDelegatedWireGuardTokens returns DelegatedWireGuardTokensQueryOrganization.DelegatedWireGuardTokens, and is useful for accessing the field via an interface.
+func (v *DelegatedWireGuardTokensQueryOrganization) GetDelegatedWireGuardTokens() DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnection {
+	return v.DelegatedWireGuardTokens
+}
+
+// DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnection includes the requested fields of the GraphQL type DelegatedWireGuardTokenConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for DelegatedWireGuardToken.
+type DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnection struct {
+	// A list of nodes.
+	Nodes []DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnectionNodesDelegatedWireGuardToken `json:"nodes"`
+}
+
+// GetNodes returns DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnection) GetNodes() []DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnectionNodesDelegatedWireGuardToken {
+	return v.Nodes
+}
+
+// DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnectionNodesDelegatedWireGuardToken includes the requested fields of the GraphQL type DelegatedWireGuardToken.
+type DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnectionNodesDelegatedWireGuardToken struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetId returns DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnectionNodesDelegatedWireGuardToken.Id, and is useful for accessing the field via an interface.
+func (v *DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnectionNodesDelegatedWireGuardToken) GetId() string {
+	return v.Id
+}
+
+// GetName returns DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnectionNodesDelegatedWireGuardToken.Name, and is useful for accessing the field via an interface.
+func (v *DelegatedWireGuardTokensQueryOrganizationDelegatedWireGuardTokensDelegatedWireGuardTokenConnectionNodesDelegatedWireGuardToken) GetName() string {
+	return v.Name
+}
+
+// DelegatedWireGuardTokensQueryResponse is returned by DelegatedWireGuardTokensQuery on success.
+type DelegatedWireGuardTokensQueryResponse struct {
+	// Find an organization by ID
+	Organization DelegatedWireGuardTokensQueryOrganization `json:"organization"`
+}
+
+// GetOrganization returns DelegatedWireGuardTokensQueryResponse.Organization, and is useful for accessing the field via an interface.
+func (v *DelegatedWireGuardTokensQueryResponse) GetOrganization() DelegatedWireGuardTokensQueryOrganization {
+	return v.Organization
+}
+
+// DeleteAppMutationDeleteAppDeleteAppPayload includes the requested fields of the GraphQL type DeleteAppPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeleteApp
+type DeleteAppMutationDeleteAppDeleteAppPayload struct {
+	// The organization that owned the deleted app
+	Organization DeleteAppMutationDeleteAppDeleteAppPayloadOrganization `json:"organization"`
+}
+
+// GetOrganization returns DeleteAppMutationDeleteAppDeleteAppPayload.Organization, and is useful for accessing the field via an interface.
+func (v *DeleteAppMutationDeleteAppDeleteAppPayload) GetOrganization() DeleteAppMutationDeleteAppDeleteAppPayloadOrganization {
+	return v.Organization
+}
+
+// DeleteAppMutationDeleteAppDeleteAppPayloadOrganization includes the requested fields of the GraphQL type Organization.
+type DeleteAppMutationDeleteAppDeleteAppPayloadOrganization struct {
+	// Organization name
+	Name string `json:"name"`
+}
+
+// GetName returns DeleteAppMutationDeleteAppDeleteAppPayloadOrganization.Name, and is useful for accessing the field via an interface.
+func (v *DeleteAppMutationDeleteAppDeleteAppPayloadOrganization) GetName() string { return v.Name }
+
+// DeleteAppMutationResponse is returned by DeleteAppMutation on success.
+type DeleteAppMutationResponse struct {
+	// Delete an app
+	DeleteApp DeleteAppMutationDeleteAppDeleteAppPayload `json:"deleteApp"`
+}
+
+// GetDeleteApp returns DeleteAppMutationResponse.DeleteApp, and is useful for accessing the field via an interface.
+func (v *DeleteAppMutationResponse) GetDeleteApp() DeleteAppMutationDeleteAppDeleteAppPayload {
+	return v.DeleteApp
+}
+
+// DeleteCertificateDeleteCertificateDeleteCertificatePayload includes the requested fields of the GraphQL type DeleteCertificatePayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeleteCertificate
+type DeleteCertificateDeleteCertificateDeleteCertificatePayload struct {
+	App         DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp                       `json:"app"`
+	Certificate DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate `json:"certificate"`
+}
+
+// GetApp returns DeleteCertificateDeleteCertificateDeleteCertificatePayload.App, and is useful for accessing the field via an interface.
+func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayload) GetApp() DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp {
+	return v.App
+}
+
+// GetCertificate returns DeleteCertificateDeleteCertificateDeleteCertificatePayload.Certificate, and is useful for accessing the field via an interface.
+func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayload) GetCertificate() DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate {
+	return v.Certificate
+}
+
+// DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp includes the requested fields of the GraphQL type App.
+type DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp struct {
+	// The unique application name
+	Name string `json:"name"`
+}
+
+// GetName returns DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp.Name, and is useful for accessing the field via an interface.
+func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayloadApp) GetName() string {
+	return v.Name
+}
+
+// DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate includes the requested fields of the GraphQL type AppCertificate.
+type DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate struct {
+	Hostname string `json:"hostname"`
+	Id       string `json:"id"`
+}
+
+// GetHostname returns DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate.Hostname, and is useful for accessing the field via an interface.
+func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate) GetHostname() string {
+	return v.Hostname
+}
+
+// GetId returns DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate.Id, and is useful for accessing the field via an interface.
+func (v *DeleteCertificateDeleteCertificateDeleteCertificatePayloadCertificateAppCertificate) GetId() string {
+	return v.Id
+}
+
+// DeleteCertificateResponse is returned by DeleteCertificate on success.
+type DeleteCertificateResponse struct {
+	DeleteCertificate DeleteCertificateDeleteCertificateDeleteCertificatePayload `json:"deleteCertificate"`
+}
+
+// GetDeleteCertificate returns DeleteCertificateResponse.DeleteCertificate, and is useful for accessing the field via an interface.
+func (v *DeleteCertificateResponse) GetDeleteCertificate() DeleteCertificateDeleteCertificateDeleteCertificatePayload {
+	return v.DeleteCertificate
+}
+
+// DeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenPayload includes the requested fields of the GraphQL type DeleteDelegatedWireGuardTokenPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeleteDelegatedWireGuardToken
+type DeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenPayload struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns DeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenPayload.Token, and is useful for accessing the field via an interface.
+func (v *DeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenPayload) GetToken() string {
+	return v.Token
+}
+
+// DeleteDelegatedWireGuardTokenResponse is returned by DeleteDelegatedWireGuardToken on success.
+type DeleteDelegatedWireGuardTokenResponse struct {
+	DeleteDelegatedWireGuardToken DeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenPayload `json:"deleteDelegatedWireGuardToken"`
+}
+
+// GetDeleteDelegatedWireGuardToken returns DeleteDelegatedWireGuardTokenResponse.DeleteDelegatedWireGuardToken, and is useful for accessing the field via an interface.
+func (v *DeleteDelegatedWireGuardTokenResponse) GetDeleteDelegatedWireGuardToken() DeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenDeleteDelegatedWireGuardTokenPayload {
+	return v.DeleteDelegatedWireGuardToken
+}
+
+// DeleteHealthCheckHandlerMutationDeleteHealthCheckHandlerDeleteHealthCheckHandlerPayload includes the requested fields of the GraphQL type DeleteHealthCheckHandlerPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeleteHealthCheckHandler
+type DeleteHealthCheckHandlerMutationDeleteHealthCheckHandlerDeleteHealthCheckHandlerPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationId string `json:"clientMutationId"`
+}
+
+// GetClientMutationId returns DeleteHealthCheckHandlerMutationDeleteHealthCheckHandlerDeleteHealthCheckHandlerPayload.ClientMutationId, and is useful for accessing the field via an interface.
+func (v *DeleteHealthCheckHandlerMutationDeleteHealthCheckHandlerDeleteHealthCheckHandlerPayload) GetClientMutationId() string {
+	return v.ClientMutationId
+}
+
+// DeleteHealthCheckHandlerMutationResponse is returned by DeleteHealthCheckHandlerMutation on success.
+type DeleteHealthCheckHandlerMutationResponse struct {
+	DeleteHealthCheckHandler DeleteHealthCheckHandlerMutationDeleteHealthCheckHandlerDeleteHealthCheckHandlerPayload `json:"deleteHealthCheckHandler"`
+}
+
+// GetDeleteHealthCheckHandler returns DeleteHealthCheckHandlerMutationResponse.DeleteHealthCheckHandler, and is useful for accessing the field via an interface.
+func (v *DeleteHealthCheckHandlerMutationResponse) GetDeleteHealthCheckHandler() DeleteHealthCheckHandlerMutationDeleteHealthCheckHandlerDeleteHealthCheckHandlerPayload {
+	return v.DeleteHealthCheckHandler
+}
+
+// DeleteOrganizationDeleteOrganizationDeleteOrganizationPayload includes the requested fields of the GraphQL type DeleteOrganizationPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeleteOrganization
+type DeleteOrganizationDeleteOrganizationDeleteOrganizationPayload struct {
+	DeletedOrganizationId string `json:"deletedOrganizationId"`
+}
+
+// GetDeletedOrganizationId returns DeleteOrganizationDeleteOrganizationDeleteOrganizationPayload.DeletedOrganizationId, and is useful for accessing the field via an interface.
+func (v *DeleteOrganizationDeleteOrganizationDeleteOrganizationPayload) GetDeletedOrganizationId() string {
+	return v.DeletedOrganizationId
+}
+
+// DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayload includes the requested fields of the GraphQL type DeleteOrganizationInvitationPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeleteOrganizationInvitation
+type DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayload struct {
+	Organization DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayloadOrganization `json:"organization"`
+}
+
+// GetOrganization returns DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayload.Organization, and is useful for accessing the field via an interface.
+func (v *DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayload) GetOrganization() DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayloadOrganization {
+	return v.Organization
+}
+
+// DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayloadOrganization includes the requested fields of the GraphQL type Organization.
+type DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayloadOrganization struct {
+	Id string `json:"id"`
+}
+
+// GetId returns DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayloadOrganization.Id, and is useful for accessing the field via an interface.
+func (v *DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayloadOrganization) GetId() string {
+	return v.Id
+}
+
+// DeleteOrganizationInvitationResponse is returned by DeleteOrganizationInvitation on success.
+type DeleteOrganizationInvitationResponse struct {
+	DeleteOrganizationInvitation DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayload `json:"deleteOrganizationInvitation"`
+}
+
+// GetDeleteOrganizationInvitation returns DeleteOrganizationInvitationResponse.DeleteOrganizationInvitation, and is useful for accessing the field via an interface.
+func (v *DeleteOrganizationInvitationResponse) GetDeleteOrganizationInvitation() DeleteOrganizationInvitationDeleteOrganizationInvitationDeleteOrganizationInvitationPayload {
+	return v.DeleteOrganizationInvitation
+}
+
+// DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayload includes the requested fields of the GraphQL type DeleteOrganizationMembershipPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeleteOrganizationMembership
+type DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayload struct {
+	User DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayloadUser `json:"user"`
+}
+
+// GetUser returns DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayload.User, and is useful for accessing the field via an interface.
+func (v *DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayload) GetUser() DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayloadUser {
+	return v.User
+}
+
+// DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayloadUser includes the requested fields of the GraphQL type User.
+type DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayloadUser struct {
+	Id string `json:"id"`
+}
+
+// GetId returns DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayloadUser.Id, and is useful for accessing the field via an interface.
+func (v *DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayloadUser) GetId() string {
+	return v.Id
+}
+
+// DeleteOrganizationMembershipResponse is returned by DeleteOrganizationMembership on success.
+type DeleteOrganizationMembershipResponse struct {
+	DeleteOrganizationMembership DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayload `json:"deleteOrganizationMembership"`
+}
+
+// GetDeleteOrganizationMembership returns DeleteOrganizationMembershipResponse.DeleteOrganizationMembership, and is useful for accessing the field via an interface.
+func (v *DeleteOrganizationMembershipResponse) GetDeleteOrganizationMembership() DeleteOrganizationMembershipDeleteOrganizationMembershipDeleteOrganizationMembershipPayload {
+	return v.DeleteOrganizationMembership
+}
+
+// DeleteOrganizationResponse is returned by DeleteOrganization on success.
+type DeleteOrganizationResponse struct {
+	DeleteOrganization DeleteOrganizationDeleteOrganizationDeleteOrganizationPayload `json:"deleteOrganization"`
+}
+
+// GetDeleteOrganization returns DeleteOrganizationResponse.DeleteOrganization, and is useful for accessing the field via an interface.
+func (v *DeleteOrganizationResponse) GetDeleteOrganization() DeleteOrganizationDeleteOrganizationDeleteOrganizationPayload {
+	return v.DeleteOrganization
+}
+
+// DeleteVolumeDeleteVolumeDeleteVolumePayload includes the requested fields of the GraphQL type DeleteVolumePayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeleteVolume
+type DeleteVolumeDeleteVolumeDeleteVolumePayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationId string `json:"clientMutationId"`
+}
+
+// GetClientMutationId returns DeleteVolumeDeleteVolumeDeleteVolumePayload.ClientMutationId, and is useful for accessing the field via an interface.
+func (v *DeleteVolumeDeleteVolumeDeleteVolumePayload) GetClientMutationId() string {
+	return v.ClientMutationId
+}
+
+// DeleteVolumeResponse is returned by DeleteVolume on success.
+type DeleteVolumeResponse struct {
+	DeleteVolume DeleteVolumeDeleteVolumeDeleteVolumePayload `json:"deleteVolume"`
+}
+
+// GetDeleteVolume returns DeleteVolumeResponse.DeleteVolume, and is useful for accessing the field via an interface.
+func (v *DeleteVolumeResponse) GetDeleteVolume() DeleteVolumeDeleteVolumeDeleteVolumePayload {
+	return v.DeleteVolume
+}
+
+// DeployImageMutationDeployImageDeployImagePayload includes the requested fields of the GraphQL type DeployImagePayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DeployImage
+type DeployImageMutationDeployImageDeployImagePayload struct {
+	Release DeployImageMutationDeployImageDeployImagePayloadRelease `json:"release"`
+}
+
+// GetRelease returns DeployImageMutationDeployImageDeployImagePayload.Release, and is useful for accessing the field via an interface.
+func (v *DeployImageMutationDeployImageDeployImagePayload) GetRelease() DeployImageMutationDeployImageDeployImagePayloadRelease {
+	return v.Release
+}
+
+// DeployImageMutationDeployImageDeployImagePayloadRelease includes the requested fields of the GraphQL type Release.
+type DeployImageMutationDeployImageDeployImagePayloadRelease struct {
+	// Unique ID
+	Id string `json:"id"`
+	// The version of the release
+	Version      int    `json:"version"`
+	EvaluationId string `json:"evaluationId"`
+}
+
+// GetId returns DeployImageMutationDeployImageDeployImagePayloadRelease.Id, and is useful for accessing the field via an interface.
+func (v *DeployImageMutationDeployImageDeployImagePayloadRelease) GetId() string { return v.Id }
+
+// GetVersion returns DeployImageMutationDeployImageDeployImagePayloadRelease.Version, and is useful for accessing the field via an interface.
+func (v *DeployImageMutationDeployImageDeployImagePayloadRelease) GetVersion() int { return v.Version }
+
+// GetEvaluationId returns DeployImageMutationDeployImageDeployImagePayloadRelease.EvaluationId, and is useful for accessing the field via an interface.
+func (v *DeployImageMutationDeployImageDeployImagePayloadRelease) GetEvaluationId() string {
+	return v.EvaluationId
+}
+
+// DeployImageMutationResponse is returned by DeployImageMutation on success.
+type DeployImageMutationResponse struct {
+	DeployImage DeployImageMutationDeployImageDeployImagePayload `json:"deployImage"`
+}
+
+// GetDeployImage returns DeployImageMutationResponse.DeployImage, and is useful for accessing the field via an interface.
+func (v *DeployImageMutationResponse) GetDeployImage() DeployImageMutationDeployImageDeployImagePayload {
+	return v.DeployImage
+}
+
+// DeploymentStatusQueryApp includes the requested fields of the GraphQL type App.
+type DeploymentStatusQueryApp struct {
+	// Find a deployment by id, defaults to latest
+	DeploymentStatus DeploymentStatusQueryAppDeploymentStatus `json:"deploymentStatus"`
+}
+
+// GetDeploymentStatus returns DeploymentStatusQueryApp.DeploymentStatus, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusQueryApp) GetDeploymentStatus() DeploymentStatusQueryAppDeploymentStatus {
+	return v.DeploymentStatus
+}
+
+// DeploymentStatusQueryAppDeploymentStatus includes the requested fields of the GraphQL type DeploymentStatus.
+type DeploymentStatusQueryAppDeploymentStatus struct {
+	// Unique ID for this deployment
+	Id           string `json:"id"`
+	Status       string `json:"status"`
+	Description  string `json:"description"`
+	InProgress   bool   `json:"inProgress"`
+	Successful   bool   `json:"successful"`
+	DesiredCount int    `json:"desiredCount"`
+	HealthyCount int    `json:"healthyCount"`
+}
+
+// GetId returns DeploymentStatusQueryAppDeploymentStatus.Id, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusQueryAppDeploymentStatus) GetId() string { return v.Id }
+
+// GetStatus returns DeploymentStatusQueryAppDeploymentStatus.Status, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusQueryAppDeploymentStatus) GetStatus() string { return v.Status }
+
+// GetDescription returns DeploymentStatusQueryAppDeploymentStatus.Description, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusQueryAppDeploymentStatus) GetDescription() string { return v.Description }
+
+// GetInProgress returns DeploymentStatusQueryAppDeploymentStatus.InProgress, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusQueryAppDeploymentStatus) GetInProgress() bool { return v.InProgress }
+
+// GetSuccessful returns DeploymentStatusQueryAppDeploymentStatus.Successful, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusQueryAppDeploymentStatus) GetSuccessful() bool { return v.Successful }
+
+// GetDesiredCount returns DeploymentStatusQueryAppDeploymentStatus.DesiredCount, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusQueryAppDeploymentStatus) GetDesiredCount() int { return v.DesiredCount }
+
+// GetHealthyCount returns DeploymentStatusQueryAppDeploymentStatus.HealthyCount, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusQueryAppDeploymentStatus) GetHealthyCount() int { return v.HealthyCount }
+
+// DeploymentStatusQueryResponse is returned by DeploymentStatusQuery on success.
+type DeploymentStatusQueryResponse struct {
+	// Find an app by name
+	App DeploymentStatusQueryApp `json:"app"`
+}
+
+// GetApp returns DeploymentStatusQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusQueryResponse) GetApp() DeploymentStatusQueryApp { return v.App }
+
+type DeploymentStrategy string
+
+const (
+	// Deploy new instances all at once
+	DeploymentStrategyImmediate DeploymentStrategy = "IMMEDIATE"
+	// Deploy new instances all at once
+	DeploymentStrategySimple DeploymentStrategy = "SIMPLE"
+	// Incrementally replace old instances with new ones
+	DeploymentStrategyRolling DeploymentStrategy = "ROLLING"
+	// Ensure new instances are healthy before continuing with a rolling deployment
+	DeploymentStrategyCanary DeploymentStrategy = "CANARY"
+	// Launch all new instances before shutting down previous instances
+	DeploymentStrategyBluegreen DeploymentStrategy = "BLUEGREEN"
+)
+
+// DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayload includes the requested fields of the GraphQL type DetachPostgresClusterPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of DetachPostgresCluster
+type DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayload struct {
+	App DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayloadApp `json:"app"`
+}
+
+// GetApp returns DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayload.App, and is useful for accessing the field via an interface.
+func (v *DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayload) GetApp() DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayloadApp {
+	return v.App
+}
+
+// DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayloadApp includes the requested fields of the GraphQL type App.
+type DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayloadApp struct {
+	// The unique application name
+	Name string `json:"name"`
+}
+
+// GetName returns DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayloadApp.Name, and is useful for accessing the field via an interface.
+func (v *DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayloadApp) GetName() string {
+	return v.Name
+}
+
+// DetachPostgresClusterMutationResponse is returned by DetachPostgresClusterMutation on success.
+type DetachPostgresClusterMutationResponse struct {
+	DetachPostgresCluster DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayload `json:"detachPostgresCluster"`
+}
+
+// GetDetachPostgresCluster returns DetachPostgresClusterMutationResponse.DetachPostgresCluster, and is useful for accessing the field via an interface.
+func (v *DetachPostgresClusterMutationResponse) GetDetachPostgresCluster() DetachPostgresClusterMutationDetachPostgresClusterDetachPostgresClusterPayload {
+	return v.DetachPostgresCluster
+}
+
+// GetCertificateApp includes the requested fields of the GraphQL type App.
+type GetCertificateApp struct {
+	// Find a certificate by hostname
+	Certificate GetCertificateAppCertificate `json:"certificate"`
+}
+
+// GetCertificate returns GetCertificateApp.Certificate, and is useful for accessing the field via an interface.
+func (v *GetCertificateApp) GetCertificate() GetCertificateAppCertificate { return v.Certificate }
+
+// GetCertificateAppCertificate includes the requested fields of the GraphQL type AppCertificate.
+type GetCertificateAppCertificate struct {
+	Id                        string `json:"id"`
+	DnsValidationInstructions string `json:"dnsValidationInstructions"`
+	DnsValidationHostname     string `json:"dnsValidationHostname"`
+	DnsValidationTarget       string `json:"dnsValidationTarget"`
+	Hostname                  string `json:"hostname"`
+	Check                     bool   `json:"check"`
+}
+
+// GetId returns GetCertificateAppCertificate.Id, and is useful for accessing the field via an interface.
+func (v *GetCertificateAppCertificate) GetId() string { return v.Id }
+
+// GetDnsValidationInstructions returns GetCertificateAppCertificate.DnsValidationInstructions, and is useful for accessing the field via an interface.
+func (v *GetCertificateAppCertificate) GetDnsValidationInstructions() string {
+	return v.DnsValidationInstructions
+}
+
+// GetDnsValidationHostname returns GetCertificateAppCertificate.DnsValidationHostname, and is useful for accessing the field via an interface.
+func (v *GetCertificateAppCertificate) GetDnsValidationHostname() string {
+	return v.DnsValidationHostname
+}
+
+// GetDnsValidationTarget returns GetCertificateAppCertificate.DnsValidationTarget, and is useful for accessing the field via an interface.
+func (v *GetCertificateAppCertificate) GetDnsValidationTarget() string { return v.DnsValidationTarget }
+
+// GetHostname returns GetCertificateAppCertificate.Hostname, and is useful for accessing the field via an interface.
+func (v *GetCertificateAppCertificate) GetHostname() string { return v.Hostname }
+
+// GetCheck returns GetCertificateAppCertificate.Check, and is useful for accessing the field via an interface.
+func (v *GetCertificateAppCertificate) GetCheck() bool { return v.Check }
+
+// GetCertificateResponse is returned by GetCertificate on success.
+type GetCertificateResponse struct {
+	// Find an app by name
+	App GetCertificateApp `json:"app"`
+}
+
+// GetApp returns GetCertificateResponse.App, and is useful for accessing the field via an interface.
+func (v *GetCertificateResponse) GetApp() GetCertificateApp { return v.App }
+
+// GetFullAppApp includes the requested fields of the GraphQL type App.
+type GetFullAppApp struct {
+	// The unique application name
+	Name    string `json:"name"`
+	Network string `json:"network"`
+	// Organization that owns this app
+	Organization GetFullAppAppOrganization                 `json:"organization"`
+	Autoscaling  GetFullAppAppAutoscalingAutoscalingConfig `json:"autoscaling"`
+	AppUrl       string                                    `json:"appUrl"`
+	// Autogenerated hostname for this application
+	Hostname string `json:"hostname"`
+	// Unique application ID
+	Id string `json:"id"`
+	// Application status
+	Status   string `json:"status"`
+	Deployed bool   `json:"deployed"`
+	// The latest release of this applicaion
+	CurrentRelease GetFullAppAppCurrentRelease                   `json:"currentRelease"`
+	Config         GetFullAppAppConfig                           `json:"config"`
+	HealthChecks   GetFullAppAppHealthChecksCheckStateConnection `json:"healthChecks"`
+	IpAddresses    GetFullAppAppIpAddressesIPAddressConnection   `json:"ipAddresses"`
+	Role           GetFullAppAppRole                             `json:"-"`
+}
+
+// GetName returns GetFullAppApp.Name, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetName() string { return v.Name }
+
+// GetNetwork returns GetFullAppApp.Network, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetNetwork() string { return v.Network }
+
+// GetOrganization returns GetFullAppApp.Organization, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetOrganization() GetFullAppAppOrganization { return v.Organization }
+
+// GetAutoscaling returns GetFullAppApp.Autoscaling, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetAutoscaling() GetFullAppAppAutoscalingAutoscalingConfig {
+	return v.Autoscaling
+}
+
+// GetAppUrl returns GetFullAppApp.AppUrl, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetAppUrl() string { return v.AppUrl }
+
+// GetHostname returns GetFullAppApp.Hostname, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetHostname() string { return v.Hostname }
+
+// GetId returns GetFullAppApp.Id, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetId() string { return v.Id }
+
+// GetStatus returns GetFullAppApp.Status, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetStatus() string { return v.Status }
+
+// GetDeployed returns GetFullAppApp.Deployed, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetDeployed() bool { return v.Deployed }
+
+// GetCurrentRelease returns GetFullAppApp.CurrentRelease, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetCurrentRelease() GetFullAppAppCurrentRelease { return v.CurrentRelease }
+
+// GetConfig returns GetFullAppApp.Config, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetConfig() GetFullAppAppConfig { return v.Config }
+
+// GetHealthChecks returns GetFullAppApp.HealthChecks, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetHealthChecks() GetFullAppAppHealthChecksCheckStateConnection {
+	return v.HealthChecks
+}
+
+// GetIpAddresses returns GetFullAppApp.IpAddresses, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetIpAddresses() GetFullAppAppIpAddressesIPAddressConnection {
+	return v.IpAddresses
+}
+
+// GetRole returns GetFullAppApp.Role, and is useful for accessing the field via an interface.
+func (v *GetFullAppApp) GetRole() GetFullAppAppRole { return v.Role }
+
+func (v *GetFullAppApp) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetFullAppApp
+		Role json.RawMessage `json:"role"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetFullAppApp = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Role
+		src := firstPass.Role
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetFullAppAppRole(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetFullAppApp.Role: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetFullAppApp struct {
+	Name string `json:"name"`
+
+	Network string `json:"network"`
+
+	Organization GetFullAppAppOrganization `json:"organization"`
+
+	Autoscaling GetFullAppAppAutoscalingAutoscalingConfig `json:"autoscaling"`
+
+	AppUrl string `json:"appUrl"`
+
+	Hostname string `json:"hostname"`
+
+	Id string `json:"id"`
+
+	Status string `json:"status"`
+
+	Deployed bool `json:"deployed"`
+
+	CurrentRelease GetFullAppAppCurrentRelease `json:"currentRelease"`
+
+	Config GetFullAppAppConfig `json:"config"`
+
+	HealthChecks GetFullAppAppHealthChecksCheckStateConnection `json:"healthChecks"`
+
+	IpAddresses GetFullAppAppIpAddressesIPAddressConnection `json:"ipAddresses"`
+
+	Role json.RawMessage `json:"role"`
+}
+
+func (v *GetFullAppApp) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetFullAppApp) __premarshalJSON() (*__premarshalGetFullAppApp, error) {
+	var retval __premarshalGetFullAppApp
+
+	retval.Name = v.Name
+	retval.Network = v.Network
+	retval.Organization = v.Organization
+	retval.Autoscaling = v.Autoscaling
+	retval.AppUrl = v.AppUrl
+	retval.Hostname = v.Hostname
+	retval.Id = v.Id
+	retval.Status = v.Status
+	retval.Deployed = v.Deployed
+	retval.CurrentRelease = v.CurrentRelease
+	retval.Config = v.Config
+	retval.HealthChecks = v.HealthChecks
+	retval.IpAddresses = v.IpAddresses
+	{
+
+		dst := &retval.Role
+		src := v.Role
+		var err error
+		*dst, err = __marshalGetFullAppAppRole(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetFullAppApp.Role: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetFullAppAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
+type GetFullAppAppAutoscalingAutoscalingConfig struct {
+	PreferredRegion string                                                                  `json:"preferredRegion"`
+	Regions         []GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig `json:"regions"`
+}
+
+// GetPreferredRegion returns GetFullAppAppAutoscalingAutoscalingConfig.PreferredRegion, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppAutoscalingAutoscalingConfig) GetPreferredRegion() string {
+	return v.PreferredRegion
+}
+
+// GetRegions returns GetFullAppAppAutoscalingAutoscalingConfig.Regions, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppAutoscalingAutoscalingConfig) GetRegions() []GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig {
+	return v.Regions
+}
+
+// GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig includes the requested fields of the GraphQL type AutoscaleRegionConfig.
+type GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig struct {
+	// The region code
+	Code string `json:"code"`
+}
+
+// GetCode returns GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig.Code, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) GetCode() string {
+	return v.Code
+}
+
+// GetFullAppAppConfig includes the requested fields of the GraphQL type AppConfig.
+type GetFullAppAppConfig struct {
+	Definition interface{} `json:"definition"`
+}
+
+// GetDefinition returns GetFullAppAppConfig.Definition, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppConfig) GetDefinition() interface{} { return v.Definition }
+
+// GetFullAppAppCurrentRelease includes the requested fields of the GraphQL type Release.
+type GetFullAppAppCurrentRelease struct {
+	// Unique ID
+	Id string `json:"id"`
+}
+
+// GetId returns GetFullAppAppCurrentRelease.Id, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppCurrentRelease) GetId() string { return v.Id }
+
+// GetFullAppAppHealthChecksCheckStateConnection includes the requested fields of the GraphQL type CheckStateConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for CheckState.
+type GetFullAppAppHealthChecksCheckStateConnection struct {
+	// A list of nodes.
+	Nodes []GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState `json:"nodes"`
+}
+
+// GetNodes returns GetFullAppAppHealthChecksCheckStateConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppHealthChecksCheckStateConnection) GetNodes() []GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState {
+	return v.Nodes
+}
+
+// GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState includes the requested fields of the GraphQL type CheckState.
+// The GraphQL type's documentation follows.
+//
+// health check state
+type GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// GetName returns GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState.Name, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState) GetName() string {
+	return v.Name
+}
+
+// GetStatus returns GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState.Status, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppHealthChecksCheckStateConnectionNodesCheckState) GetStatus() string {
+	return v.Status
+}
+
+// GetFullAppAppIpAddressesIPAddressConnection includes the requested fields of the GraphQL type IPAddressConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for IPAddress.
+type GetFullAppAppIpAddressesIPAddressConnection struct {
+	// A list of nodes.
+	Nodes []GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress `json:"nodes"`
+}
+
+// GetNodes returns GetFullAppAppIpAddressesIPAddressConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppIpAddressesIPAddressConnection) GetNodes() []GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress {
+	return v.Nodes
+}
+
+// GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress includes the requested fields of the GraphQL type IPAddress.
+type GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress struct {
+	Address string `json:"address"`
+	Id      string `json:"id"`
+}
+
+// GetAddress returns GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress.Address, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress) GetAddress() string {
+	return v.Address
+}
+
+// GetId returns GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress.Id, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppIpAddressesIPAddressConnectionNodesIPAddress) GetId() string { return v.Id }
+
+// GetFullAppAppOrganization includes the requested fields of the GraphQL type Organization.
+type GetFullAppAppOrganization struct {
+	Id string `json:"id"`
+	// Unique organization slug
+	Slug string `json:"slug"`
+}
+
+// GetId returns GetFullAppAppOrganization.Id, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppOrganization) GetId() string { return v.Id }
+
+// GetSlug returns GetFullAppAppOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppOrganization) GetSlug() string { return v.Slug }
+
+// GetFullAppAppRole includes the requested fields of the GraphQL interface AppRole.
+//
+// GetFullAppAppRole is implemented by the following types:
+// GetFullAppAppRoleEmptyAppRole
+// GetFullAppAppRoleFlyctlMachineHostAppRole
+// GetFullAppAppRolePostgresClusterAppRole
+// GetFullAppAppRoleRemoteDockerBuilderAppRole
+type GetFullAppAppRole interface {
+	implementsGraphQLInterfaceGetFullAppAppRole()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The name of this role
+	GetName() string
+}
+
+func (v *GetFullAppAppRoleEmptyAppRole) implementsGraphQLInterfaceGetFullAppAppRole()               {}
+func (v *GetFullAppAppRoleFlyctlMachineHostAppRole) implementsGraphQLInterfaceGetFullAppAppRole()   {}
+func (v *GetFullAppAppRolePostgresClusterAppRole) implementsGraphQLInterfaceGetFullAppAppRole()     {}
+func (v *GetFullAppAppRoleRemoteDockerBuilderAppRole) implementsGraphQLInterfaceGetFullAppAppRole() {}
+
+func __unmarshalGetFullAppAppRole(b []byte, v *GetFullAppAppRole) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "EmptyAppRole":
+		*v = new(GetFullAppAppRoleEmptyAppRole)
+		return json.Unmarshal(b, *v)
+	case "FlyctlMachineHostAppRole":
+		*v = new(GetFullAppAppRoleFlyctlMachineHostAppRole)
+		return json.Unmarshal(b, *v)
+	case "PostgresClusterAppRole":
+		*v = new(GetFullAppAppRolePostgresClusterAppRole)
+		return json.Unmarshal(b, *v)
+	case "RemoteDockerBuilderAppRole":
+		*v = new(GetFullAppAppRoleRemoteDockerBuilderAppRole)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing AppRole.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetFullAppAppRole: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetFullAppAppRole(v *GetFullAppAppRole) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetFullAppAppRoleEmptyAppRole:
+		typename = "EmptyAppRole"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFullAppAppRoleEmptyAppRole
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFullAppAppRoleFlyctlMachineHostAppRole:
+		typename = "FlyctlMachineHostAppRole"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFullAppAppRoleFlyctlMachineHostAppRole
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFullAppAppRolePostgresClusterAppRole:
+		typename = "PostgresClusterAppRole"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFullAppAppRolePostgresClusterAppRole
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFullAppAppRoleRemoteDockerBuilderAppRole:
+		typename = "RemoteDockerBuilderAppRole"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFullAppAppRoleRemoteDockerBuilderAppRole
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetFullAppAppRole: "%T"`, v)
+	}
+}
+
+// GetFullAppAppRoleEmptyAppRole includes the requested fields of the GraphQL type EmptyAppRole.
+type GetFullAppAppRoleEmptyAppRole struct {
+	Typename string `json:"__typename"`
+	// The name of this role
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetFullAppAppRoleEmptyAppRole.Typename, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppRoleEmptyAppRole) GetTypename() string { return v.Typename }
+
+// GetName returns GetFullAppAppRoleEmptyAppRole.Name, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppRoleEmptyAppRole) GetName() string { return v.Name }
+
+// GetFullAppAppRoleFlyctlMachineHostAppRole includes the requested fields of the GraphQL type FlyctlMachineHostAppRole.
+type GetFullAppAppRoleFlyctlMachineHostAppRole struct {
+	Typename string `json:"__typename"`
+	// The name of this role
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetFullAppAppRoleFlyctlMachineHostAppRole.Typename, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppRoleFlyctlMachineHostAppRole) GetTypename() string { return v.Typename }
+
+// GetName returns GetFullAppAppRoleFlyctlMachineHostAppRole.Name, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppRoleFlyctlMachineHostAppRole) GetName() string { return v.Name }
+
+// GetFullAppAppRolePostgresClusterAppRole includes the requested fields of the GraphQL type PostgresClusterAppRole.
+type GetFullAppAppRolePostgresClusterAppRole struct {
+	Typename string `json:"__typename"`
+	// The name of this role
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetFullAppAppRolePostgresClusterAppRole.Typename, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppRolePostgresClusterAppRole) GetTypename() string { return v.Typename }
+
+// GetName returns GetFullAppAppRolePostgresClusterAppRole.Name, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppRolePostgresClusterAppRole) GetName() string { return v.Name }
+
+// GetFullAppAppRoleRemoteDockerBuilderAppRole includes the requested fields of the GraphQL type RemoteDockerBuilderAppRole.
+type GetFullAppAppRoleRemoteDockerBuilderAppRole struct {
+	Typename string `json:"__typename"`
+	// The name of this role
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetFullAppAppRoleRemoteDockerBuilderAppRole.Typename, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppRoleRemoteDockerBuilderAppRole) GetTypename() string { return v.Typename }
+
+// GetName returns GetFullAppAppRoleRemoteDockerBuilderAppRole.Name, and is useful for accessing the field via an interface.
+func (v *GetFullAppAppRoleRemoteDockerBuilderAppRole) GetName() string { return v.Name }
+
+// GetFullAppResponse is returned by GetFullApp on success.
+type GetFullAppResponse struct {
+	// Find an app by name
+	App GetFullAppApp `json:"app"`
+}
+
+// GetApp returns GetFullAppResponse.App, and is useful for accessing the field via an interface.
+func (v *GetFullAppResponse) GetApp() GetFullAppApp { return v.App }
+
+// GetFullOrganizationOrganization includes the requested fields of the GraphQL type Organization.
+type GetFullOrganizationOrganization struct {
+	Id string `json:"id"`
+	// Unique organization slug
+	Slug string `json:"slug"`
+	// Organization name
+	Name string `json:"name"`
+	// The type of organization
+	Type          OrganizationType `json:"type"`
+	BillingStatus BillingStatus    `json:"billingStatus"`
+}
+
+// GetId returns GetFullOrganizationOrganization.Id, and is useful for accessing the field via an interface.
+func (v *GetFullOrganizationOrganization) GetId() string { return v.Id }
+
+// GetSlug returns GetFullOrganizationOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *GetFullOrganizationOrganization) GetSlug() string { return v.Slug }
+
+// GetName returns GetFullOrganizationOrganization.Name, and is useful for accessing the field via an interface.
+func (v *GetFullOrganizationOrganization) GetName() string { return v.Name }
+
+// GetType returns GetFullOrganizationOrganization.Type, and is useful for accessing the field via an interface.
+func (v *GetFullOrganizationOrganization) GetType() OrganizationType { return v.Type }
+
+// GetBillingStatus returns GetFullOrganizationOrganization.BillingStatus, and is useful for accessing the field via an interface.
+func (v *GetFullOrganizationOrganization) GetBillingStatus() BillingStatus { return v.BillingStatus }
+
+// GetFullOrganizationResponse is returned by GetFullOrganization on success.
+type GetFullOrganizationResponse struct {
+	// Find an organization by ID
+	Organization GetFullOrganizationOrganization `json:"organization"`
+}
+
+// GetOrganization returns GetFullOrganizationResponse.Organization, and is useful for accessing the field via an interface.
+func (v *GetFullOrganizationResponse) GetOrganization() GetFullOrganizationOrganization {
+	return v.Organization
+}
+
+// GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayload includes the requested fields of the GraphQL type GrantPostgresClusterUserAccessPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of GrantPostgresClusterUserAccess
+type GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayload struct {
+	User GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayloadUserPostgresClusterUser `json:"user"`
+}
+
+// GetUser returns GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayload.User, and is useful for accessing the field via an interface.
+func (v *GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayload) GetUser() GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayloadUserPostgresClusterUser {
+	return v.User
+}
+
+// GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayloadUserPostgresClusterUser includes the requested fields of the GraphQL type PostgresClusterUser.
+type GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayloadUserPostgresClusterUser struct {
+	Username  string   `json:"username"`
+	Databases []string `json:"databases"`
+}
+
+// GetUsername returns GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayloadUserPostgresClusterUser.Username, and is useful for accessing the field via an interface.
+func (v *GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayloadUserPostgresClusterUser) GetUsername() string {
+	return v.Username
+}
+
+// GetDatabases returns GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayloadUserPostgresClusterUser.Databases, and is useful for accessing the field via an interface.
+func (v *GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayloadUserPostgresClusterUser) GetDatabases() []string {
+	return v.Databases
+}
+
+// GrantPostgresClusterUserAccessMutationResponse is returned by GrantPostgresClusterUserAccessMutation on success.
+type GrantPostgresClusterUserAccessMutationResponse struct {
+	GrantPostgresClusterUserAccess GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayload `json:"grantPostgresClusterUserAccess"`
+}
+
+// GetGrantPostgresClusterUserAccess returns GrantPostgresClusterUserAccessMutationResponse.GrantPostgresClusterUserAccess, and is useful for accessing the field via an interface.
+func (v *GrantPostgresClusterUserAccessMutationResponse) GetGrantPostgresClusterUserAccess() GrantPostgresClusterUserAccessMutationGrantPostgresClusterUserAccessGrantPostgresClusterUserAccessPayload {
+	return v.GrantPostgresClusterUserAccess
+}
+
+// HealthCheckHandlersQueryOrganization includes the requested fields of the GraphQL type Organization.
+type HealthCheckHandlersQueryOrganization struct {
+	HealthCheckHandlers HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnection `json:"healthCheckHandlers"`
+}
+
+// GetHealthCheckHandlers returns HealthCheckHandlersQueryOrganization.HealthCheckHandlers, and is useful for accessing the field via an interface.
+func (v *HealthCheckHandlersQueryOrganization) GetHealthCheckHandlers() HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnection {
+	return v.HealthCheckHandlers
+}
+
+// HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnection includes the requested fields of the GraphQL type HealthCheckHandlerConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for HealthCheckHandler.
+type HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnection struct {
+	// A list of nodes.
+	Nodes []HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnectionNodesHealthCheckHandler `json:"nodes"`
+}
+
+// GetNodes returns HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnection) GetNodes() []HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnectionNodesHealthCheckHandler {
+	return v.Nodes
+}
+
+// HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnectionNodesHealthCheckHandler includes the requested fields of the GraphQL type HealthCheckHandler.
+type HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnectionNodesHealthCheckHandler struct {
+	// Handler name
+	Name string `json:"name"`
+	// Handler type (Slack or Pagerduty)
+	Type string `json:"type"`
+}
+
+// GetName returns HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnectionNodesHealthCheckHandler.Name, and is useful for accessing the field via an interface.
+func (v *HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnectionNodesHealthCheckHandler) GetName() string {
+	return v.Name
+}
+
+// GetType returns HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnectionNodesHealthCheckHandler.Type, and is useful for accessing the field via an interface.
+func (v *HealthCheckHandlersQueryOrganizationHealthCheckHandlersHealthCheckHandlerConnectionNodesHealthCheckHandler) GetType() string {
+	return v.Type
+}
+
+// HealthCheckHandlersQueryResponse is returned by HealthCheckHandlersQuery on success.
+type HealthCheckHandlersQueryResponse struct {
+	// Find an organization by ID
+	Organization HealthCheckHandlersQueryOrganization `json:"organization"`
+}
+
+// GetOrganization returns HealthCheckHandlersQueryResponse.Organization, and is useful for accessing the field via an interface.
+func (v *HealthCheckHandlersQueryResponse) GetOrganization() HealthCheckHandlersQueryOrganization {
+	return v.Organization
+}
+
+type IPAddressType string
+
+const (
+	IPAddressTypeV4 IPAddressType = "v4"
+	IPAddressTypeV6 IPAddressType = "v6"
+)
+
+// IpAddressQueryApp includes the requested fields of the GraphQL type App.
+type IpAddressQueryApp struct {
+	// Find an ip address by address string
+	IpAddress IpAddressQueryAppIpAddressIPAddress `json:"ipAddress"`
+}
+
+// GetIpAddress returns IpAddressQueryApp.IpAddress, and is useful for accessing the field via an interface.
+func (v *IpAddressQueryApp) GetIpAddress() IpAddressQueryAppIpAddressIPAddress { return v.IpAddress }
+
+// IpAddressQueryAppIpAddressIPAddress includes the requested fields of the GraphQL type IPAddress.
+type IpAddressQueryAppIpAddressIPAddress struct {
+	Id      string        `json:"id"`
+	Type    IPAddressType `json:"type"`
+	Address string        `json:"address"`
+	Region  string        `json:"region"`
+}
+
+// GetId returns IpAddressQueryAppIpAddressIPAddress.Id, and is useful for accessing the field via an interface.
+func (v *IpAddressQueryAppIpAddressIPAddress) GetId() string { return v.Id }
+
+// GetType returns IpAddressQueryAppIpAddressIPAddress.Type, and is useful for accessing the field via an interface.
+func (v *IpAddressQueryAppIpAddressIPAddress) GetType() IPAddressType { return v.Type }
+
+// GetAddress returns IpAddressQueryAppIpAddressIPAddress.Address, and is useful for accessing the field via an interface.
+func (v *IpAddressQueryAppIpAddressIPAddress) GetAddress() string { return v.Address }
+
+// GetRegion returns IpAddressQueryAppIpAddressIPAddress.Region, and is useful for accessing the field via an interface.
+func (v *IpAddressQueryAppIpAddressIPAddress) GetRegion() string { return v.Region }
+
+// IpAddressQueryResponse is returned by IpAddressQuery on success.
+type IpAddressQueryResponse struct {
+	// Find an app by name
+	App IpAddressQueryApp `json:"app"`
+}
+
+// GetApp returns IpAddressQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *IpAddressQueryResponse) GetApp() IpAddressQueryApp { return v.App }
+
+// MoveAppMutationMoveAppMoveAppPayload includes the requested fields of the GraphQL type MoveAppPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of MoveApp
+type MoveAppMutationMoveAppMoveAppPayload struct {
+	App MoveAppMutationMoveAppMoveAppPayloadApp `json:"app"`
+}
+
+// GetApp returns MoveAppMutationMoveAppMoveAppPayload.App, and is useful for accessing the field via an interface.
+func (v *MoveAppMutationMoveAppMoveAppPayload) GetApp() MoveAppMutationMoveAppMoveAppPayloadApp {
+	return v.App
+}
+
+// MoveAppMutationMoveAppMoveAppPayloadApp includes the requested fields of the GraphQL type App.
+type MoveAppMutationMoveAppMoveAppPayloadApp struct {
+	// The unique application name
+	Name string `json:"name"`
+	// Organization that owns this app
+	Organization MoveAppMutationMoveAppMoveAppPayloadAppOrganization `json:"organization"`
+}
+
+// GetName returns MoveAppMutationMoveAppMoveAppPayloadApp.Name, and is useful for accessing the field via an interface.
+func (v *MoveAppMutationMoveAppMoveAppPayloadApp) GetName() string { return v.Name }
+
+// GetOrganization returns MoveAppMutationMoveAppMoveAppPayloadApp.Organization, and is useful for accessing the field via an interface.
+func (v *MoveAppMutationMoveAppMoveAppPayloadApp) GetOrganization() MoveAppMutationMoveAppMoveAppPayloadAppOrganization {
+	return v.Organization
+}
+
+// MoveAppMutationMoveAppMoveAppPayloadAppOrganization includes the requested fields of the GraphQL type Organization.
+type MoveAppMutationMoveAppMoveAppPayloadAppOrganization struct {
+	Id string `json:"id"`
+	// Unique organization slug
+	Slug string `json:"slug"`
+}
+
+// GetId returns MoveAppMutationMoveAppMoveAppPayloadAppOrganization.Id, and is useful for accessing the field via an interface.
+func (v *MoveAppMutationMoveAppMoveAppPayloadAppOrganization) GetId() string { return v.Id }
+
+// GetSlug returns MoveAppMutationMoveAppMoveAppPayloadAppOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *MoveAppMutationMoveAppMoveAppPayloadAppOrganization) GetSlug() string { return v.Slug }
+
+// MoveAppMutationResponse is returned by MoveAppMutation on success.
+type MoveAppMutationResponse struct {
+	MoveApp MoveAppMutationMoveAppMoveAppPayload `json:"moveApp"`
+}
+
+// GetMoveApp returns MoveAppMutationResponse.MoveApp, and is useful for accessing the field via an interface.
+func (v *MoveAppMutationResponse) GetMoveApp() MoveAppMutationMoveAppMoveAppPayload { return v.MoveApp }
+
+// OrgInvitationFragment includes the GraphQL fields of OrganizationInvitation requested by the fragment OrgInvitationFragment.
+type OrgInvitationFragment struct {
+	Id       string `json:"id"`
+	Email    string `json:"email"`
+	Redeemed bool   `json:"redeemed"`
+}
+
+// GetId returns OrgInvitationFragment.Id, and is useful for accessing the field via an interface.
+func (v *OrgInvitationFragment) GetId() string { return v.Id }
+
+// GetEmail returns OrgInvitationFragment.Email, and is useful for accessing the field via an interface.
+func (v *OrgInvitationFragment) GetEmail() string { return v.Email }
+
+// GetRedeemed returns OrgInvitationFragment.Redeemed, and is useful for accessing the field via an interface.
+func (v *OrgInvitationFragment) GetRedeemed() bool { return v.Redeemed }
+
+// OrgInvitationsQueryOrganization includes the requested fields of the GraphQL type Organization.
+type OrgInvitationsQueryOrganization struct {
+	Invitations OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnection `json:"invitations"`
+}
+
+// GetInvitations returns OrgInvitationsQueryOrganization.Invitations, and is useful for accessing the field via an interface.
+func (v *OrgInvitationsQueryOrganization) GetInvitations() OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnection {
+	return v.Invitations
+}
+
+// OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnection includes the requested fields of the GraphQL type OrganizationInvitationConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for OrganizationInvitation.
+type OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnection struct {
+	// A list of nodes.
+	Nodes []OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation `json:"nodes"`
+	// Information to aid in pagination.
+	PageInfo OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnection) GetNodes() []OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation {
+	return v.Nodes
+}
+
+// GetPageInfo returns OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnection) GetPageInfo() OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionPageInfo {
+	return v.PageInfo
+}
+
+// OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation includes the requested fields of the GraphQL type OrganizationInvitation.
+type OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation struct {
+	OrgInvitationFragment `json:"-"`
+}
+
+// GetId returns OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation.Id, and is useful for accessing the field via an interface.
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation) GetId() string {
+	return v.OrgInvitationFragment.Id
+}
+
+// GetEmail returns OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation.Email, and is useful for accessing the field via an interface.
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation) GetEmail() string {
+	return v.OrgInvitationFragment.Email
+}
+
+// GetRedeemed returns OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation.Redeemed, and is useful for accessing the field via an interface.
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation) GetRedeemed() bool {
+	return v.OrgInvitationFragment.Redeemed
+}
+
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrgInvitationFragment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalOrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Redeemed bool `json:"redeemed"`
+}
+
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation) __premarshalJSON() (*__premarshalOrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation, error) {
+	var retval __premarshalOrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionNodesOrganizationInvitation
+
+	retval.Id = v.OrgInvitationFragment.Id
+	retval.Email = v.OrgInvitationFragment.Email
+	retval.Redeemed = v.OrgInvitationFragment.Redeemed
+	return &retval, nil
+}
+
+// OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *OrgInvitationsQueryOrganizationInvitationsOrganizationInvitationConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// OrgInvitationsQueryResponse is returned by OrgInvitationsQuery on success.
+type OrgInvitationsQueryResponse struct {
+	// Find an organization by ID
+	Organization OrgInvitationsQueryOrganization `json:"organization"`
+}
+
+// GetOrganization returns OrgInvitationsQueryResponse.Organization, and is useful for accessing the field via an interface.
+func (v *OrgInvitationsQueryResponse) GetOrganization() OrgInvitationsQueryOrganization {
+	return v.Organization
+}
+
+// OrgMemberFragment includes the GraphQL fields of OrganizationMembershipsEdge requested by the fragment OrgMemberFragment.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type OrgMemberFragment struct {
+	// The role the user has in this organization
+	Role OrganizationMemberRole `json:"role"`
+	// The date the user joined the organization
+	JoinedAt time.Time `json:"joinedAt"`
+	// The item at the end of the edge.
+	Node OrgMemberFragmentNodeUser `json:"node"`
+}
+
+// GetRole returns OrgMemberFragment.Role, and is useful for accessing the field via an interface.
+func (v *OrgMemberFragment) GetRole() OrganizationMemberRole { return v.Role }
+
+// GetJoinedAt returns OrgMemberFragment.JoinedAt, and is useful for accessing the field via an interface.
+func (v *OrgMemberFragment) GetJoinedAt() time.Time { return v.JoinedAt }
+
+// GetNode returns OrgMemberFragment.Node, and is useful for accessing the field via an interface.
+func (v *OrgMemberFragment) GetNode() OrgMemberFragmentNodeUser { return v.Node }
+
+// OrgMemberFragmentNodeUser includes the requested fields of the GraphQL type User.
+type OrgMemberFragmentNodeUser struct {
+	Id string `json:"id"`
+	// Email address for user (private)
+	Email string `json:"email"`
+	// Display / full name for user (private)
+	Name string `json:"name"`
+}
+
+// GetId returns OrgMemberFragmentNodeUser.Id, and is useful for accessing the field via an interface.
+func (v *OrgMemberFragmentNodeUser) GetId() string { return v.Id }
+
+// GetEmail returns OrgMemberFragmentNodeUser.Email, and is useful for accessing the field via an interface.
+func (v *OrgMemberFragmentNodeUser) GetEmail() string { return v.Email }
+
+// GetName returns OrgMemberFragmentNodeUser.Name, and is useful for accessing the field via an interface.
+func (v *OrgMemberFragmentNodeUser) GetName() string { return v.Name }
+
+// OrgMembersQueryOrganization includes the requested fields of the GraphQL type Organization.
+type OrgMembersQueryOrganization struct {
+	Members OrgMembersQueryOrganizationMembersOrganizationMembershipsConnection `json:"members"`
+}
+
+// GetMembers returns OrgMembersQueryOrganization.Members, and is useful for accessing the field via an interface.
+func (v *OrgMembersQueryOrganization) GetMembers() OrgMembersQueryOrganizationMembersOrganizationMembershipsConnection {
+	return v.Members
+}
+
+// OrgMembersQueryOrganizationMembersOrganizationMembershipsConnection includes the requested fields of the GraphQL type OrganizationMembershipsConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for User.
+type OrgMembersQueryOrganizationMembersOrganizationMembershipsConnection struct {
+	// A list of edges.
+	Edges []OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns OrgMembersQueryOrganizationMembersOrganizationMembershipsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnection) GetEdges() []OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns OrgMembersQueryOrganizationMembersOrganizationMembershipsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnection) GetPageInfo() OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge includes the requested fields of the GraphQL type OrganizationMembershipsEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge struct {
+	OrgMemberFragment `json:"-"`
+}
+
+// GetRole returns OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge.Role, and is useful for accessing the field via an interface.
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge) GetRole() OrganizationMemberRole {
+	return v.OrgMemberFragment.Role
+}
+
+// GetJoinedAt returns OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge.JoinedAt, and is useful for accessing the field via an interface.
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge) GetJoinedAt() time.Time {
+	return v.OrgMemberFragment.JoinedAt
+}
+
+// GetNode returns OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge.Node, and is useful for accessing the field via an interface.
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge) GetNode() OrgMemberFragmentNodeUser {
+	return v.OrgMemberFragment.Node
+}
+
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrgMemberFragment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalOrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge struct {
+	Role OrganizationMemberRole `json:"role"`
+
+	JoinedAt time.Time `json:"joinedAt"`
+
+	Node OrgMemberFragmentNodeUser `json:"node"`
+}
+
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge) __premarshalJSON() (*__premarshalOrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge, error) {
+	var retval __premarshalOrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionEdgesOrganizationMembershipsEdge
+
+	retval.Role = v.OrgMemberFragment.Role
+	retval.JoinedAt = v.OrgMemberFragment.JoinedAt
+	retval.Node = v.OrgMemberFragment.Node
+	return &retval, nil
+}
+
+// OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *OrgMembersQueryOrganizationMembersOrganizationMembershipsConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// OrgMembersQueryResponse is returned by OrgMembersQuery on success.
+type OrgMembersQueryResponse struct {
+	// Find an organization by ID
+	Organization OrgMembersQueryOrganization `json:"organization"`
+}
+
+// GetOrganization returns OrgMembersQueryResponse.Organization, and is useful for accessing the field via an interface.
+func (v *OrgMembersQueryResponse) GetOrganization() OrgMembersQueryOrganization {
+	return v.Organization
+}
+
+// OrgQueryOrganization includes the requested fields of the GraphQL type Organization.
+type OrgQueryOrganization struct {
+	// Organization name
+	Name string `json:"name"`
+	Id   string `json:"id"`
+	// Unique organization slug
+	Slug string `json:"slug"`
+}
+
+// GetName returns OrgQueryOrganization.Name, and is useful for accessing the field via an interface.
+func (v *OrgQueryOrganization) GetName() string { return v.Name }
+
+// GetId returns OrgQueryOrganization.Id, and is useful for accessing the field via an interface.
+func (v *OrgQueryOrganization) GetId() string { return v.Id }
+
+// GetSlug returns OrgQueryOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *OrgQueryOrganization) GetSlug() string { return v.Slug }
+
+// OrgQueryResponse is returned by OrgQuery on success.
+type OrgQueryResponse struct {
+	// Find an organization by ID
+	Organization OrgQueryOrganization `json:"organization"`
+}
+
+// GetOrganization returns OrgQueryResponse.Organization, and is useful for accessing the field via an interface.
+func (v *OrgQueryResponse) GetOrganization() OrgQueryOrganization { return v.Organization }
+
+type OrganizationMemberRole string
+
+const (
+	// The user is an administrator of the organization
+	OrganizationMemberRoleAdmin OrganizationMemberRole = "ADMIN"
+	// The user is a member of the organization
+	OrganizationMemberRoleMember OrganizationMemberRole = "MEMBER"
+)
+
+type OrganizationType string
+
+const (
+	// A user's personal organization
+	OrganizationTypePersonal OrganizationType = "PERSONAL"
+	// An organization shared between one or more users
+	OrganizationTypeShared OrganizationType = "SHARED"
+)
+
+// OrgsQueryOrganizationsOrganizationConnection includes the requested fields of the GraphQL type OrganizationConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Organization.
+type OrgsQueryOrganizationsOrganizationConnection struct {
+	// A list of nodes.
+	Nodes []OrgsQueryOrganizationsOrganizationConnectionNodesOrganization `json:"nodes"`
+	// Information to aid in pagination.
+	PageInfo OrgsQueryOrganizationsOrganizationConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns OrgsQueryOrganizationsOrganizationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *OrgsQueryOrganizationsOrganizationConnection) GetNodes() []OrgsQueryOrganizationsOrganizationConnectionNodesOrganization {
+	return v.Nodes
+}
+
+// GetPageInfo returns OrgsQueryOrganizationsOrganizationConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *OrgsQueryOrganizationsOrganizationConnection) GetPageInfo() OrgsQueryOrganizationsOrganizationConnectionPageInfo {
+	return v.PageInfo
+}
+
+// OrgsQueryOrganizationsOrganizationConnectionNodesOrganization includes the requested fields of the GraphQL type Organization.
+type OrgsQueryOrganizationsOrganizationConnectionNodesOrganization struct {
+	// Organization name
+	Name string `json:"name"`
+	Id   string `json:"id"`
+	// Unique organization slug
+	Slug string `json:"slug"`
+}
+
+// GetName returns OrgsQueryOrganizationsOrganizationConnectionNodesOrganization.Name, and is useful for accessing the field via an interface.
+func (v *OrgsQueryOrganizationsOrganizationConnectionNodesOrganization) GetName() string {
+	return v.Name
+}
+
+// GetId returns OrgsQueryOrganizationsOrganizationConnectionNodesOrganization.Id, and is useful for accessing the field via an interface.
+func (v *OrgsQueryOrganizationsOrganizationConnectionNodesOrganization) GetId() string { return v.Id }
+
+// GetSlug returns OrgsQueryOrganizationsOrganizationConnectionNodesOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *OrgsQueryOrganizationsOrganizationConnectionNodesOrganization) GetSlug() string {
+	return v.Slug
+}
+
+// OrgsQueryOrganizationsOrganizationConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type OrgsQueryOrganizationsOrganizationConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns OrgsQueryOrganizationsOrganizationConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *OrgsQueryOrganizationsOrganizationConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns OrgsQueryOrganizationsOrganizationConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *OrgsQueryOrganizationsOrganizationConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// OrgsQueryResponse is returned by OrgsQuery on success.
+type OrgsQueryResponse struct {
+	Organizations OrgsQueryOrganizationsOrganizationConnection `json:"organizations"`
+}
+
+// GetOrganizations returns OrgsQueryResponse.Organizations, and is useful for accessing the field via an interface.
+func (v *OrgsQueryResponse) GetOrganizations() OrgsQueryOrganizationsOrganizationConnection {
+	return v.Organizations
+}
+
+// ParseConfigQueryApp includes the requested fields of the GraphQL type App.
+type ParseConfigQueryApp struct {
+	ParseConfig ParseConfigQueryAppParseConfigAppConfig `json:"parseConfig"`
+}
+
+// GetParseConfig returns ParseConfigQueryApp.ParseConfig, and is useful for accessing the field via an interface.
+func (v *ParseConfigQueryApp) GetParseConfig() ParseConfigQueryAppParseConfigAppConfig {
+	return v.ParseConfig
+}
+
+// ParseConfigQueryAppParseConfigAppConfig includes the requested fields of the GraphQL type AppConfig.
+type ParseConfigQueryAppParseConfigAppConfig struct {
+	Definition interface{} `json:"definition"`
+	Valid      bool        `json:"valid"`
+	Errors     []string    `json:"errors"`
+}
+
+// GetDefinition returns ParseConfigQueryAppParseConfigAppConfig.Definition, and is useful for accessing the field via an interface.
+func (v *ParseConfigQueryAppParseConfigAppConfig) GetDefinition() interface{} { return v.Definition }
+
+// GetValid returns ParseConfigQueryAppParseConfigAppConfig.Valid, and is useful for accessing the field via an interface.
+func (v *ParseConfigQueryAppParseConfigAppConfig) GetValid() bool { return v.Valid }
+
+// GetErrors returns ParseConfigQueryAppParseConfigAppConfig.Errors, and is useful for accessing the field via an interface.
+func (v *ParseConfigQueryAppParseConfigAppConfig) GetErrors() []string { return v.Errors }
+
+// ParseConfigQueryResponse is returned by ParseConfigQuery on success.
+type ParseConfigQueryResponse struct {
+	// Find an app by name
+	App ParseConfigQueryApp `json:"app"`
+}
+
+// GetApp returns ParseConfigQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *ParseConfigQueryResponse) GetApp() ParseConfigQueryApp { return v.App }
+
+// PauseAppMutationPauseAppPauseAppPayload includes the requested fields of the GraphQL type PauseAppPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of PauseApp
+type PauseAppMutationPauseAppPauseAppPayload struct {
+	App PauseAppMutationPauseAppPauseAppPayloadApp `json:"app"`
+}
+
+// GetApp returns PauseAppMutationPauseAppPauseAppPayload.App, and is useful for accessing the field via an interface.
+func (v *PauseAppMutationPauseAppPauseAppPayload) GetApp() PauseAppMutationPauseAppPauseAppPayloadApp {
+	return v.App
+}
+
+// PauseAppMutationPauseAppPauseAppPayloadApp includes the requested fields of the GraphQL type App.
+type PauseAppMutationPauseAppPauseAppPayloadApp struct {
+	// Application status
+	Status string `json:"status"`
+}
+
+// GetStatus returns PauseAppMutationPauseAppPauseAppPayloadApp.Status, and is useful for accessing the field via an interface.
+func (v *PauseAppMutationPauseAppPauseAppPayloadApp) GetStatus() string { return v.Status }
+
+// PauseAppMutationResponse is returned by PauseAppMutation on success.
+type PauseAppMutationResponse struct {
+	PauseApp PauseAppMutationPauseAppPauseAppPayload `json:"pauseApp"`
+}
+
+// GetPauseApp returns PauseAppMutationResponse.PauseApp, and is useful for accessing the field via an interface.
+func (v *PauseAppMutationResponse) GetPauseApp() PauseAppMutationPauseAppPauseAppPayload {
+	return v.PauseApp
+}
+
+// PostgresAttachmentAppsQueryApp includes the requested fields of the GraphQL type App.
+type PostgresAttachmentAppsQueryApp struct {
+	// Unique application ID
+	Id string `json:"id"`
+}
+
+// GetId returns PostgresAttachmentAppsQueryApp.Id, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentAppsQueryApp) GetId() string { return v.Id }
+
+// PostgresAttachmentAppsQueryPostgresApp includes the requested fields of the GraphQL type App.
+type PostgresAttachmentAppsQueryPostgresApp struct {
+	// Unique application ID
+	Id string `json:"id"`
+}
+
+// GetId returns PostgresAttachmentAppsQueryPostgresApp.Id, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentAppsQueryPostgresApp) GetId() string { return v.Id }
+
+// PostgresAttachmentAppsQueryResponse is returned by PostgresAttachmentAppsQuery on success.
+type PostgresAttachmentAppsQueryResponse struct {
+	// Find an app by name
+	PostgresApp PostgresAttachmentAppsQueryPostgresApp `json:"postgresApp"`
+	// Find an app by name
+	App PostgresAttachmentAppsQueryApp `json:"app"`
+}
+
+// GetPostgresApp returns PostgresAttachmentAppsQueryResponse.PostgresApp, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentAppsQueryResponse) GetPostgresApp() PostgresAttachmentAppsQueryPostgresApp {
+	return v.PostgresApp
+}
+
+// GetApp returns PostgresAttachmentAppsQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentAppsQueryResponse) GetApp() PostgresAttachmentAppsQueryApp { return v.App }
+
+// PostgresAttachmentFragment includes the GraphQL fields of PostgresClusterAttachment requested by the fragment PostgresAttachmentFragment.
+type PostgresAttachmentFragment struct {
+	Id                      string `json:"id"`
+	DatabaseName            string `json:"databaseName"`
+	DatabaseUser            string `json:"databaseUser"`
+	EnvironmentVariableName string `json:"environmentVariableName"`
+}
+
+// GetId returns PostgresAttachmentFragment.Id, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentFragment) GetId() string { return v.Id }
+
+// GetDatabaseName returns PostgresAttachmentFragment.DatabaseName, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentFragment) GetDatabaseName() string { return v.DatabaseName }
+
+// GetDatabaseUser returns PostgresAttachmentFragment.DatabaseUser, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentFragment) GetDatabaseUser() string { return v.DatabaseUser }
+
+// GetEnvironmentVariableName returns PostgresAttachmentFragment.EnvironmentVariableName, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentFragment) GetEnvironmentVariableName() string {
+	return v.EnvironmentVariableName
+}
+
+// PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnection includes the requested fields of the GraphQL type PostgresClusterAttachmentConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PostgresClusterAttachment.
+type PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnection struct {
+	// A list of nodes.
+	Nodes []PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment `json:"nodes"`
+}
+
+// GetNodes returns PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnection) GetNodes() []PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment {
+	return v.Nodes
+}
+
+// PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment includes the requested fields of the GraphQL type PostgresClusterAttachment.
+type PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment struct {
+	PostgresAttachmentFragment `json:"-"`
+}
+
+// GetId returns PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment.Id, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment) GetId() string {
+	return v.PostgresAttachmentFragment.Id
+}
+
+// GetDatabaseName returns PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment.DatabaseName, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment) GetDatabaseName() string {
+	return v.PostgresAttachmentFragment.DatabaseName
+}
+
+// GetDatabaseUser returns PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment.DatabaseUser, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment) GetDatabaseUser() string {
+	return v.PostgresAttachmentFragment.DatabaseUser
+}
+
+// GetEnvironmentVariableName returns PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment.EnvironmentVariableName, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment) GetEnvironmentVariableName() string {
+	return v.PostgresAttachmentFragment.EnvironmentVariableName
+}
+
+func (v *PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PostgresAttachmentFragment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalPostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment struct {
+	Id string `json:"id"`
+
+	DatabaseName string `json:"databaseName"`
+
+	DatabaseUser string `json:"databaseUser"`
+
+	EnvironmentVariableName string `json:"environmentVariableName"`
+}
+
+func (v *PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment) __premarshalJSON() (*__premarshalPostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment, error) {
+	var retval __premarshalPostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnectionNodesPostgresClusterAttachment
+
+	retval.Id = v.PostgresAttachmentFragment.Id
+	retval.DatabaseName = v.PostgresAttachmentFragment.DatabaseName
+	retval.DatabaseUser = v.PostgresAttachmentFragment.DatabaseUser
+	retval.EnvironmentVariableName = v.PostgresAttachmentFragment.EnvironmentVariableName
+	return &retval, nil
+}
+
+// PostgresAttachmentsQueryResponse is returned by PostgresAttachmentsQuery on success.
+type PostgresAttachmentsQueryResponse struct {
+	// List postgres attachments
+	PostgresAttachments PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnection `json:"postgresAttachments"`
+}
+
+// GetPostgresAttachments returns PostgresAttachmentsQueryResponse.PostgresAttachments, and is useful for accessing the field via an interface.
+func (v *PostgresAttachmentsQueryResponse) GetPostgresAttachments() PostgresAttachmentsQueryPostgresAttachmentsPostgresClusterAttachmentConnection {
+	return v.PostgresAttachments
+}
+
+// PostgresClusterRoleQueryApp includes the requested fields of the GraphQL type App.
+type PostgresClusterRoleQueryApp struct {
+	Role PostgresClusterRoleQueryAppRole `json:"-"`
+}
+
+// GetRole returns PostgresClusterRoleQueryApp.Role, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryApp) GetRole() PostgresClusterRoleQueryAppRole { return v.Role }
+
+func (v *PostgresClusterRoleQueryApp) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PostgresClusterRoleQueryApp
+		Role json.RawMessage `json:"role"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PostgresClusterRoleQueryApp = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Role
+		src := firstPass.Role
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalPostgresClusterRoleQueryAppRole(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal PostgresClusterRoleQueryApp.Role: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalPostgresClusterRoleQueryApp struct {
+	Role json.RawMessage `json:"role"`
+}
+
+func (v *PostgresClusterRoleQueryApp) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PostgresClusterRoleQueryApp) __premarshalJSON() (*__premarshalPostgresClusterRoleQueryApp, error) {
+	var retval __premarshalPostgresClusterRoleQueryApp
+
+	{
+
+		dst := &retval.Role
+		src := v.Role
+		var err error
+		*dst, err = __marshalPostgresClusterRoleQueryAppRole(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal PostgresClusterRoleQueryApp.Role: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// PostgresClusterRoleQueryAppRole includes the requested fields of the GraphQL interface AppRole.
+//
+// PostgresClusterRoleQueryAppRole is implemented by the following types:
+// PostgresClusterRoleQueryAppRoleEmptyAppRole
+// PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole
+// PostgresClusterRoleQueryAppRolePostgresClusterAppRole
+// PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole
+type PostgresClusterRoleQueryAppRole interface {
+	implementsGraphQLInterfacePostgresClusterRoleQueryAppRole()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The name of this role
+	GetName() string
+}
+
+func (v *PostgresClusterRoleQueryAppRoleEmptyAppRole) implementsGraphQLInterfacePostgresClusterRoleQueryAppRole() {
+}
+func (v *PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole) implementsGraphQLInterfacePostgresClusterRoleQueryAppRole() {
+}
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRole) implementsGraphQLInterfacePostgresClusterRoleQueryAppRole() {
+}
+func (v *PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole) implementsGraphQLInterfacePostgresClusterRoleQueryAppRole() {
+}
+
+func __unmarshalPostgresClusterRoleQueryAppRole(b []byte, v *PostgresClusterRoleQueryAppRole) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "EmptyAppRole":
+		*v = new(PostgresClusterRoleQueryAppRoleEmptyAppRole)
+		return json.Unmarshal(b, *v)
+	case "FlyctlMachineHostAppRole":
+		*v = new(PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole)
+		return json.Unmarshal(b, *v)
+	case "PostgresClusterAppRole":
+		*v = new(PostgresClusterRoleQueryAppRolePostgresClusterAppRole)
+		return json.Unmarshal(b, *v)
+	case "RemoteDockerBuilderAppRole":
+		*v = new(PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing AppRole.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for PostgresClusterRoleQueryAppRole: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalPostgresClusterRoleQueryAppRole(v *PostgresClusterRoleQueryAppRole) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *PostgresClusterRoleQueryAppRoleEmptyAppRole:
+		typename = "EmptyAppRole"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PostgresClusterRoleQueryAppRoleEmptyAppRole
+		}{typename, v}
+		return json.Marshal(result)
+	case *PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole:
+		typename = "FlyctlMachineHostAppRole"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole
+		}{typename, v}
+		return json.Marshal(result)
+	case *PostgresClusterRoleQueryAppRolePostgresClusterAppRole:
+		typename = "PostgresClusterAppRole"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PostgresClusterRoleQueryAppRolePostgresClusterAppRole
+		}{typename, v}
+		return json.Marshal(result)
+	case *PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole:
+		typename = "RemoteDockerBuilderAppRole"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for PostgresClusterRoleQueryAppRole: "%T"`, v)
+	}
+}
+
+// PostgresClusterRoleQueryAppRoleEmptyAppRole includes the requested fields of the GraphQL type EmptyAppRole.
+type PostgresClusterRoleQueryAppRoleEmptyAppRole struct {
+	Typename string `json:"__typename"`
+	// The name of this role
+	Name string `json:"name"`
+}
+
+// GetTypename returns PostgresClusterRoleQueryAppRoleEmptyAppRole.Typename, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRoleEmptyAppRole) GetTypename() string { return v.Typename }
+
+// GetName returns PostgresClusterRoleQueryAppRoleEmptyAppRole.Name, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRoleEmptyAppRole) GetName() string { return v.Name }
+
+// PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole includes the requested fields of the GraphQL type FlyctlMachineHostAppRole.
+type PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole struct {
+	Typename string `json:"__typename"`
+	// The name of this role
+	Name string `json:"name"`
+}
+
+// GetTypename returns PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole.Typename, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole.Name, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRoleFlyctlMachineHostAppRole) GetName() string { return v.Name }
+
+// PostgresClusterRoleQueryAppRolePostgresClusterAppRole includes the requested fields of the GraphQL type PostgresClusterAppRole.
+type PostgresClusterRoleQueryAppRolePostgresClusterAppRole struct {
+	Typename string `json:"__typename"`
+	// The name of this role
+	Name      string                                                                                  `json:"name"`
+	Databases []PostgresClusterRoleQueryAppRolePostgresClusterAppRoleDatabasesPostgresClusterDatabase `json:"databases"`
+	Users     []PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser         `json:"users"`
+}
+
+// GetTypename returns PostgresClusterRoleQueryAppRolePostgresClusterAppRole.Typename, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRole) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns PostgresClusterRoleQueryAppRolePostgresClusterAppRole.Name, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRole) GetName() string { return v.Name }
+
+// GetDatabases returns PostgresClusterRoleQueryAppRolePostgresClusterAppRole.Databases, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRole) GetDatabases() []PostgresClusterRoleQueryAppRolePostgresClusterAppRoleDatabasesPostgresClusterDatabase {
+	return v.Databases
+}
+
+// GetUsers returns PostgresClusterRoleQueryAppRolePostgresClusterAppRole.Users, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRole) GetUsers() []PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser {
+	return v.Users
+}
+
+// PostgresClusterRoleQueryAppRolePostgresClusterAppRoleDatabasesPostgresClusterDatabase includes the requested fields of the GraphQL type PostgresClusterDatabase.
+type PostgresClusterRoleQueryAppRolePostgresClusterAppRoleDatabasesPostgresClusterDatabase struct {
+	Name  string   `json:"name"`
+	Users []string `json:"users"`
+}
+
+// GetName returns PostgresClusterRoleQueryAppRolePostgresClusterAppRoleDatabasesPostgresClusterDatabase.Name, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRoleDatabasesPostgresClusterDatabase) GetName() string {
+	return v.Name
+}
+
+// GetUsers returns PostgresClusterRoleQueryAppRolePostgresClusterAppRoleDatabasesPostgresClusterDatabase.Users, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRoleDatabasesPostgresClusterDatabase) GetUsers() []string {
+	return v.Users
+}
+
+// PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser includes the requested fields of the GraphQL type PostgresClusterUser.
+type PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser struct {
+	Username    string   `json:"username"`
+	IsSuperuser bool     `json:"isSuperuser"`
+	Databases   []string `json:"databases"`
+}
+
+// GetUsername returns PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser.Username, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser) GetUsername() string {
+	return v.Username
+}
+
+// GetIsSuperuser returns PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser.IsSuperuser, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser) GetIsSuperuser() bool {
+	return v.IsSuperuser
+}
+
+// GetDatabases returns PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser.Databases, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRolePostgresClusterAppRoleUsersPostgresClusterUser) GetDatabases() []string {
+	return v.Databases
+}
+
+// PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole includes the requested fields of the GraphQL type RemoteDockerBuilderAppRole.
+type PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole struct {
+	Typename string `json:"__typename"`
+	// The name of this role
+	Name string `json:"name"`
+}
+
+// GetTypename returns PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole.Typename, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole.Name, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryAppRoleRemoteDockerBuilderAppRole) GetName() string { return v.Name }
+
+// PostgresClusterRoleQueryResponse is returned by PostgresClusterRoleQuery on success.
+type PostgresClusterRoleQueryResponse struct {
+	// Find an app by name
+	App PostgresClusterRoleQueryApp `json:"app"`
+}
+
+// GetApp returns PostgresClusterRoleQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *PostgresClusterRoleQueryResponse) GetApp() PostgresClusterRoleQueryApp { return v.App }
+
+// ReleaseFragment includes the GraphQL fields of Release requested by the fragment ReleaseFragment.
+type ReleaseFragment struct {
+	// Unique ID
+	Id string `json:"id"`
+	// The version of the release
+	Version int `json:"version"`
+	// The status of the release
+	Status string `json:"status"`
+	// The reason for the release
+	Reason string `json:"reason"`
+	// A description of the release
+	Description string `json:"description"`
+	Stable      bool   `json:"stable"`
+	// Docker image URI
+	ImageRef string `json:"imageRef"`
+	// The user who created the release
+	User      ReleaseFragmentUser `json:"user"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+// GetId returns ReleaseFragment.Id, and is useful for accessing the field via an interface.
+func (v *ReleaseFragment) GetId() string { return v.Id }
+
+// GetVersion returns ReleaseFragment.Version, and is useful for accessing the field via an interface.
+func (v *ReleaseFragment) GetVersion() int { return v.Version }
+
+// GetStatus returns ReleaseFragment.Status, and is useful for accessing the field via an interface.
+func (v *ReleaseFragment) GetStatus() string { return v.Status }
+
+// GetReason returns ReleaseFragment.Reason, and is useful for accessing the field via an interface.
+func (v *ReleaseFragment) GetReason() string { return v.Reason }
+
+// GetDescription returns ReleaseFragment.Description, and is useful for accessing the field via an interface.
+func (v *ReleaseFragment) GetDescription() string { return v.Description }
+
+// GetStable returns ReleaseFragment.Stable, and is useful for accessing the field via an interface.
+func (v *ReleaseFragment) GetStable() bool { return v.Stable }
+
+// GetImageRef returns ReleaseFragment.ImageRef, and is useful for accessing the field via an interface.
+func (v *ReleaseFragment) GetImageRef() string { return v.ImageRef }
+
+// GetUser returns ReleaseFragment.User, and is useful for accessing the field via an interface.
+func (v *ReleaseFragment) GetUser() ReleaseFragmentUser { return v.User }
+
+// GetCreatedAt returns ReleaseFragment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ReleaseFragment) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// ReleaseFragmentUser includes the requested fields of the GraphQL type User.
+type ReleaseFragmentUser struct {
+	// Email address for user (private)
+	Email string `json:"email"`
+}
+
+// GetEmail returns ReleaseFragmentUser.Email, and is useful for accessing the field via an interface.
+func (v *ReleaseFragmentUser) GetEmail() string { return v.Email }
+
+// ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload includes the requested fields of the GraphQL type ReleaseIPAddressPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of ReleaseIPAddress
+type ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload struct {
+	App ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp `json:"app"`
+}
+
+// GetApp returns ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload.App, and is useful for accessing the field via an interface.
+func (v *ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload) GetApp() ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp {
+	return v.App
+}
+
+// ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp includes the requested fields of the GraphQL type App.
+type ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp struct {
+	// The unique application name
+	Name string `json:"name"`
+}
+
+// GetName returns ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp.Name, and is useful for accessing the field via an interface.
+func (v *ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayloadApp) GetName() string { return v.Name }
+
+// ReleaseIpAddressResponse is returned by ReleaseIpAddress on success.
+type ReleaseIpAddressResponse struct {
+	ReleaseIpAddress ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload `json:"releaseIpAddress"`
+}
+
+// GetReleaseIpAddress returns ReleaseIpAddressResponse.ReleaseIpAddress, and is useful for accessing the field via an interface.
+func (v *ReleaseIpAddressResponse) GetReleaseIpAddress() ReleaseIpAddressReleaseIpAddressReleaseIPAddressPayload {
+	return v.ReleaseIpAddress
+}
+
+// ReleaseQueryApp includes the requested fields of the GraphQL type App.
+type ReleaseQueryApp struct {
+	// Find a specific release
+	Release ReleaseQueryAppRelease `json:"release"`
+}
+
+// GetRelease returns ReleaseQueryApp.Release, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryApp) GetRelease() ReleaseQueryAppRelease { return v.Release }
+
+// ReleaseQueryAppRelease includes the requested fields of the GraphQL type Release.
+type ReleaseQueryAppRelease struct {
+	ReleaseFragment `json:"-"`
+	Config          ReleaseQueryAppReleaseConfigAppConfig `json:"config"`
+}
+
+// GetConfig returns ReleaseQueryAppRelease.Config, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetConfig() ReleaseQueryAppReleaseConfigAppConfig { return v.Config }
+
+// GetId returns ReleaseQueryAppRelease.Id, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetId() string { return v.ReleaseFragment.Id }
+
+// GetVersion returns ReleaseQueryAppRelease.Version, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetVersion() int { return v.ReleaseFragment.Version }
+
+// GetStatus returns ReleaseQueryAppRelease.Status, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetStatus() string { return v.ReleaseFragment.Status }
+
+// GetReason returns ReleaseQueryAppRelease.Reason, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetReason() string { return v.ReleaseFragment.Reason }
+
+// GetDescription returns ReleaseQueryAppRelease.Description, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetDescription() string { return v.ReleaseFragment.Description }
+
+// GetStable returns ReleaseQueryAppRelease.Stable, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetStable() bool { return v.ReleaseFragment.Stable }
+
+// GetImageRef returns ReleaseQueryAppRelease.ImageRef, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetImageRef() string { return v.ReleaseFragment.ImageRef }
+
+// GetUser returns ReleaseQueryAppRelease.User, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetUser() ReleaseFragmentUser { return v.ReleaseFragment.User }
+
+// GetCreatedAt returns ReleaseQueryAppRelease.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppRelease) GetCreatedAt() time.Time { return v.ReleaseFragment.CreatedAt }
+
+func (v *ReleaseQueryAppRelease) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ReleaseQueryAppRelease
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ReleaseQueryAppRelease = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReleaseFragment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalReleaseQueryAppRelease struct {
+	Config ReleaseQueryAppReleaseConfigAppConfig `json:"config"`
+
+	Id string `json:"id"`
+
+	Version int `json:"version"`
+
+	Status string `json:"status"`
+
+	Reason string `json:"reason"`
+
+	Description string `json:"description"`
+
+	Stable bool `json:"stable"`
+
+	ImageRef string `json:"imageRef"`
+
+	User ReleaseFragmentUser `json:"user"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *ReleaseQueryAppRelease) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ReleaseQueryAppRelease) __premarshalJSON() (*__premarshalReleaseQueryAppRelease, error) {
+	var retval __premarshalReleaseQueryAppRelease
+
+	retval.Config = v.Config
+	retval.Id = v.ReleaseFragment.Id
+	retval.Version = v.ReleaseFragment.Version
+	retval.Status = v.ReleaseFragment.Status
+	retval.Reason = v.ReleaseFragment.Reason
+	retval.Description = v.ReleaseFragment.Description
+	retval.Stable = v.ReleaseFragment.Stable
+	retval.ImageRef = v.ReleaseFragment.ImageRef
+	retval.User = v.ReleaseFragment.User
+	retval.CreatedAt = v.ReleaseFragment.CreatedAt
+	return &retval, nil
+}
+
+// ReleaseQueryAppReleaseConfigAppConfig includes the requested fields of the GraphQL type AppConfig.
+type ReleaseQueryAppReleaseConfigAppConfig struct {
+	Definition interface{} `json:"definition"`
+}
+
+// GetDefinition returns ReleaseQueryAppReleaseConfigAppConfig.Definition, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryAppReleaseConfigAppConfig) GetDefinition() interface{} { return v.Definition }
+
+// ReleaseQueryResponse is returned by ReleaseQuery on success.
+type ReleaseQueryResponse struct {
+	// Find an app by name
+	App ReleaseQueryApp `json:"app"`
+}
+
+// GetApp returns ReleaseQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *ReleaseQueryResponse) GetApp() ReleaseQueryApp { return v.App }
+
+// ReleasesQueryApp includes the requested fields of the GraphQL type App.
+type ReleasesQueryApp struct {
+	// Individual releases for this application
+	Releases ReleasesQueryAppReleasesReleaseConnection `json:"releases"`
+}
+
+// GetReleases returns ReleasesQueryApp.Releases, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryApp) GetReleases() ReleasesQueryAppReleasesReleaseConnection { return v.Releases }
+
+// ReleasesQueryAppReleasesReleaseConnection includes the requested fields of the GraphQL type ReleaseConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Release.
+type ReleasesQueryAppReleasesReleaseConnection struct {
+	// A list of nodes.
+	Nodes []ReleasesQueryAppReleasesReleaseConnectionNodesRelease `json:"nodes"`
+}
+
+// GetNodes returns ReleasesQueryAppReleasesReleaseConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnection) GetNodes() []ReleasesQueryAppReleasesReleaseConnectionNodesRelease {
+	return v.Nodes
+}
+
+// ReleasesQueryAppReleasesReleaseConnectionNodesRelease includes the requested fields of the GraphQL type Release.
+type ReleasesQueryAppReleasesReleaseConnectionNodesRelease struct {
+	ReleaseFragment `json:"-"`
+}
+
+// GetId returns ReleasesQueryAppReleasesReleaseConnectionNodesRelease.Id, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) GetId() string {
+	return v.ReleaseFragment.Id
+}
+
+// GetVersion returns ReleasesQueryAppReleasesReleaseConnectionNodesRelease.Version, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) GetVersion() int {
+	return v.ReleaseFragment.Version
+}
+
+// GetStatus returns ReleasesQueryAppReleasesReleaseConnectionNodesRelease.Status, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) GetStatus() string {
+	return v.ReleaseFragment.Status
+}
+
+// GetReason returns ReleasesQueryAppReleasesReleaseConnectionNodesRelease.Reason, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) GetReason() string {
+	return v.ReleaseFragment.Reason
+}
+
+// GetDescription returns ReleasesQueryAppReleasesReleaseConnectionNodesRelease.Description, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) GetDescription() string {
+	return v.ReleaseFragment.Description
+}
+
+// GetStable returns ReleasesQueryAppReleasesReleaseConnectionNodesRelease.Stable, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) GetStable() bool {
+	return v.ReleaseFragment.Stable
+}
+
+// GetImageRef returns ReleasesQueryAppReleasesReleaseConnectionNodesRelease.ImageRef, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) GetImageRef() string {
+	return v.ReleaseFragment.ImageRef
+}
+
+// GetUser returns ReleasesQueryAppReleasesReleaseConnectionNodesRelease.User, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) GetUser() ReleaseFragmentUser {
+	return v.ReleaseFragment.User
+}
+
+// GetCreatedAt returns ReleasesQueryAppReleasesReleaseConnectionNodesRelease.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) GetCreatedAt() time.Time {
+	return v.ReleaseFragment.CreatedAt
+}
+
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ReleasesQueryAppReleasesReleaseConnectionNodesRelease
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ReleasesQueryAppReleasesReleaseConnectionNodesRelease = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReleaseFragment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalReleasesQueryAppReleasesReleaseConnectionNodesRelease struct {
+	Id string `json:"id"`
+
+	Version int `json:"version"`
+
+	Status string `json:"status"`
+
+	Reason string `json:"reason"`
+
+	Description string `json:"description"`
+
+	Stable bool `json:"stable"`
+
+	ImageRef string `json:"imageRef"`
+
+	User ReleaseFragmentUser `json:"user"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ReleasesQueryAppReleasesReleaseConnectionNodesRelease) __premarshalJSON() (*__premarshalReleasesQueryAppReleasesReleaseConnectionNodesRelease, error) {
+	var retval __premarshalReleasesQueryAppReleasesReleaseConnectionNodesRelease
+
+	retval.Id = v.ReleaseFragment.Id
+	retval.Version = v.ReleaseFragment.Version
+	retval.Status = v.ReleaseFragment.Status
+	retval.Reason = v.ReleaseFragment.Reason
+	retval.Description = v.ReleaseFragment.Description
+	retval.Stable = v.ReleaseFragment.Stable
+	retval.ImageRef = v.ReleaseFragment.ImageRef
+	retval.User = v.ReleaseFragment.User
+	retval.CreatedAt = v.ReleaseFragment.CreatedAt
+	return &retval, nil
+}
+
+// ReleasesQueryResponse is returned by ReleasesQuery on success.
+type ReleasesQueryResponse struct {
+	// Find an app by name
+	App ReleasesQueryApp `json:"app"`
+}
+
+// GetApp returns ReleasesQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *ReleasesQueryResponse) GetApp() ReleasesQueryApp { return v.App }
+
+// Autogenerated input type of RemoveWireGuardPeer
+type RemoveWireGuardPeerInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationId string `json:"clientMutationId"`
+	// The node ID of the organization
+	OrganizationId string `json:"organizationId"`
+	// The name of the peer to remove
+	Name string `json:"name"`
+	// Add via NATS transaction (for testing only, nosy users)
+	Nats bool `json:"nats"`
+}
+
+// GetClientMutationId returns RemoveWireGuardPeerInput.ClientMutationId, and is useful for accessing the field via an interface.
+func (v *RemoveWireGuardPeerInput) GetClientMutationId() string { return v.ClientMutationId }
+
+// GetOrganizationId returns RemoveWireGuardPeerInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *RemoveWireGuardPeerInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetName returns RemoveWireGuardPeerInput.Name, and is useful for accessing the field via an interface.
+func (v *RemoveWireGuardPeerInput) GetName() string { return v.Name }
+
+// GetNats returns RemoveWireGuardPeerInput.Nats, and is useful for accessing the field via an interface.
+func (v *RemoveWireGuardPeerInput) GetNats() bool { return v.Nats }
+
+// RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload includes the requested fields of the GraphQL type RemoveWireGuardPeerPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of RemoveWireGuardPeer
+type RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload struct {
+	// The organization that owned the peer
+	Organization RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization `json:"organization"`
+}
+
+// GetOrganization returns RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload.Organization, and is useful for accessing the field via an interface.
+func (v *RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload) GetOrganization() RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization {
+	return v.Organization
+}
+
+// RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization includes the requested fields of the GraphQL type Organization.
+type RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization struct {
+	// Organization name
+	Name string `json:"name"`
+}
+
+// GetName returns RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization.Name, and is useful for accessing the field via an interface.
+func (v *RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayloadOrganization) GetName() string {
+	return v.Name
+}
+
+// RemoveWireguardPeerResponse is returned by RemoveWireguardPeer on success.
+type RemoveWireguardPeerResponse struct {
+	RemoveWireGuardPeer RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload `json:"removeWireGuardPeer"`
+}
+
+// GetRemoveWireGuardPeer returns RemoveWireguardPeerResponse.RemoveWireGuardPeer, and is useful for accessing the field via an interface.
+func (v *RemoveWireguardPeerResponse) GetRemoveWireGuardPeer() RemoveWireguardPeerRemoveWireGuardPeerRemoveWireGuardPeerPayload {
+	return v.RemoveWireGuardPeer
+}
+
+// RestartAppMutationResponse is returned by RestartAppMutation on success.
+type RestartAppMutationResponse struct {
+	RestartApp RestartAppMutationRestartAppRestartAppPayload `json:"restartApp"`
+}
+
+// GetRestartApp returns RestartAppMutationResponse.RestartApp, and is useful for accessing the field via an interface.
+func (v *RestartAppMutationResponse) GetRestartApp() RestartAppMutationRestartAppRestartAppPayload {
+	return v.RestartApp
+}
+
+// RestartAppMutationRestartAppRestartAppPayload includes the requested fields of the GraphQL type RestartAppPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of RestartApp
+type RestartAppMutationRestartAppRestartAppPayload struct {
+	App RestartAppMutationRestartAppRestartAppPayloadApp `json:"app"`
+}
+
+// GetApp returns RestartAppMutationRestartAppRestartAppPayload.App, and is useful for accessing the field via an interface.
+func (v *RestartAppMutationRestartAppRestartAppPayload) GetApp() RestartAppMutationRestartAppRestartAppPayloadApp {
+	return v.App
+}
+
+// RestartAppMutationRestartAppRestartAppPayloadApp includes the requested fields of the GraphQL type App.
+type RestartAppMutationRestartAppRestartAppPayloadApp struct {
+	// Application status
+	Status string `json:"status"`
+}
+
+// GetStatus returns RestartAppMutationRestartAppRestartAppPayloadApp.Status, and is useful for accessing the field via an interface.
+func (v *RestartAppMutationRestartAppRestartAppPayloadApp) GetStatus() string { return v.Status }
+
+// ResumeAppMutationResponse is returned by ResumeAppMutation on success.
+type ResumeAppMutationResponse struct {
+	ResumeApp ResumeAppMutationResumeAppResumeAppPayload `json:"resumeApp"`
+}
+
+// GetResumeApp returns ResumeAppMutationResponse.ResumeApp, and is useful for accessing the field via an interface.
+func (v *ResumeAppMutationResponse) GetResumeApp() ResumeAppMutationResumeAppResumeAppPayload {
+	return v.ResumeApp
+}
+
+// ResumeAppMutationResumeAppResumeAppPayload includes the requested fields of the GraphQL type ResumeAppPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of ResumeApp
+type ResumeAppMutationResumeAppResumeAppPayload struct {
+	App ResumeAppMutationResumeAppResumeAppPayloadApp `json:"app"`
+}
+
+// GetApp returns ResumeAppMutationResumeAppResumeAppPayload.App, and is useful for accessing the field via an interface.
+func (v *ResumeAppMutationResumeAppResumeAppPayload) GetApp() ResumeAppMutationResumeAppResumeAppPayloadApp {
+	return v.App
+}
+
+// ResumeAppMutationResumeAppResumeAppPayloadApp includes the requested fields of the GraphQL type App.
+type ResumeAppMutationResumeAppResumeAppPayloadApp struct {
+	// Application status
+	Status string `json:"status"`
+}
+
+// GetStatus returns ResumeAppMutationResumeAppResumeAppPayloadApp.Status, and is useful for accessing the field via an interface.
+func (v *ResumeAppMutationResumeAppResumeAppPayloadApp) GetStatus() string { return v.Status }
+
+// RevokePostgresClusterUserAccessMutationResponse is returned by RevokePostgresClusterUserAccessMutation on success.
+type RevokePostgresClusterUserAccessMutationResponse struct {
+	RevokePostgresClusterUserAccess RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayload `json:"revokePostgresClusterUserAccess"`
+}
+
+// GetRevokePostgresClusterUserAccess returns RevokePostgresClusterUserAccessMutationResponse.RevokePostgresClusterUserAccess, and is useful for accessing the field via an interface.
+func (v *RevokePostgresClusterUserAccessMutationResponse) GetRevokePostgresClusterUserAccess() RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayload {
+	return v.RevokePostgresClusterUserAccess
+}
+
+// RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayload includes the requested fields of the GraphQL type RevokePostgresClusterUserAccessPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of RevokePostgresClusterUserAccess
+type RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayload struct {
+	User RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayloadUserPostgresClusterUser `json:"user"`
+}
+
+// GetUser returns RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayload.User, and is useful for accessing the field via an interface.
+func (v *RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayload) GetUser() RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayloadUserPostgresClusterUser {
+	return v.User
+}
+
+// RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayloadUserPostgresClusterUser includes the requested fields of the GraphQL type PostgresClusterUser.
+type RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayloadUserPostgresClusterUser struct {
+	Username  string   `json:"username"`
+	Databases []string `json:"databases"`
+}
+
+// GetUsername returns RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayloadUserPostgresClusterUser.Username, and is useful for accessing the field via an interface.
+func (v *RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayloadUserPostgresClusterUser) GetUsername() string {
+	return v.Username
+}
+
+// GetDatabases returns RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayloadUserPostgresClusterUser.Databases, and is useful for accessing the field via an interface.
+func (v *RevokePostgresClusterUserAccessMutationRevokePostgresClusterUserAccessRevokePostgresClusterUserAccessPayloadUserPostgresClusterUser) GetDatabases() []string {
+	return v.Databases
+}
+
+// ScaleAppMutationResponse is returned by ScaleAppMutation on success.
+type ScaleAppMutationResponse struct {
+	ScaleApp ScaleAppMutationScaleAppScaleAppPayload `json:"scaleApp"`
+}
+
+// GetScaleApp returns ScaleAppMutationResponse.ScaleApp, and is useful for accessing the field via an interface.
+func (v *ScaleAppMutationResponse) GetScaleApp() ScaleAppMutationScaleAppScaleAppPayload {
+	return v.ScaleApp
+}
+
+// ScaleAppMutationScaleAppScaleAppPayload includes the requested fields of the GraphQL type ScaleAppPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of ScaleApp
+type ScaleAppMutationScaleAppScaleAppPayload struct {
+	Placement []ScaleAppMutationScaleAppScaleAppPayloadPlacementRegionPlacement `json:"placement"`
+}
+
+// GetPlacement returns ScaleAppMutationScaleAppScaleAppPayload.Placement, and is useful for accessing the field via an interface.
+func (v *ScaleAppMutationScaleAppScaleAppPayload) GetPlacement() []ScaleAppMutationScaleAppScaleAppPayloadPlacementRegionPlacement {
+	return v.Placement
+}
+
+// ScaleAppMutationScaleAppScaleAppPayloadPlacementRegionPlacement includes the requested fields of the GraphQL type RegionPlacement.
+type ScaleAppMutationScaleAppScaleAppPayloadPlacementRegionPlacement struct {
+	// The region code
+	Region string `json:"region"`
+	// The desired number of allocations
+	Count int `json:"count"`
+}
+
+// GetRegion returns ScaleAppMutationScaleAppScaleAppPayloadPlacementRegionPlacement.Region, and is useful for accessing the field via an interface.
+func (v *ScaleAppMutationScaleAppScaleAppPayloadPlacementRegionPlacement) GetRegion() string {
+	return v.Region
+}
+
+// GetCount returns ScaleAppMutationScaleAppScaleAppPayloadPlacementRegionPlacement.Count, and is useful for accessing the field via an interface.
+func (v *ScaleAppMutationScaleAppScaleAppPayloadPlacementRegionPlacement) GetCount() int {
+	return v.Count
+}
+
+// Region placement configuration
+type ScaleRegionInput struct {
+	// The region to configure
+	Region string `json:"region"`
+	// The value to change by
+	Count int `json:"count"`
+}
+
+// GetRegion returns ScaleRegionInput.Region, and is useful for accessing the field via an interface.
+func (v *ScaleRegionInput) GetRegion() string { return v.Region }
+
+// GetCount returns ScaleRegionInput.Count, and is useful for accessing the field via an interface.
+func (v *ScaleRegionInput) GetCount() int { return v.Count }
+
+// SetPagerdutyHandlerMutationResponse is returned by SetPagerdutyHandlerMutation on success.
+type SetPagerdutyHandlerMutationResponse struct {
+	SetPagerdutyHandler SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayload `json:"setPagerdutyHandler"`
+}
+
+// GetSetPagerdutyHandler returns SetPagerdutyHandlerMutationResponse.SetPagerdutyHandler, and is useful for accessing the field via an interface.
+func (v *SetPagerdutyHandlerMutationResponse) GetSetPagerdutyHandler() SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayload {
+	return v.SetPagerdutyHandler
+}
+
+// SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayload includes the requested fields of the GraphQL type SetPagerdutyHandlerPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of SetPagerdutyHandler
+type SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayload struct {
+	Handler SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayloadHandlerHealthCheckHandler `json:"handler"`
+}
+
+// GetHandler returns SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayload.Handler, and is useful for accessing the field via an interface.
+func (v *SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayload) GetHandler() SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayloadHandlerHealthCheckHandler {
+	return v.Handler
+}
+
+// SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayloadHandlerHealthCheckHandler includes the requested fields of the GraphQL type HealthCheckHandler.
+type SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayloadHandlerHealthCheckHandler struct {
+	// Handler name
+	Name string `json:"name"`
+	// Handler type (Slack or Pagerduty)
+	Type string `json:"type"`
+}
+
+// GetName returns SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayloadHandlerHealthCheckHandler.Name, and is useful for accessing the field via an interface.
+func (v *SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayloadHandlerHealthCheckHandler) GetName() string {
+	return v.Name
+}
+
+// GetType returns SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayloadHandlerHealthCheckHandler.Type, and is useful for accessing the field via an interface.
+func (v *SetPagerdutyHandlerMutationSetPagerdutyHandlerSetPagerdutyHandlerPayloadHandlerHealthCheckHandler) GetType() string {
+	return v.Type
+}
+
+// SetSlackHandlerMutationResponse is returned by SetSlackHandlerMutation on success.
+type SetSlackHandlerMutationResponse struct {
+	SetSlackHandler SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayload `json:"setSlackHandler"`
+}
+
+// GetSetSlackHandler returns SetSlackHandlerMutationResponse.SetSlackHandler, and is useful for accessing the field via an interface.
+func (v *SetSlackHandlerMutationResponse) GetSetSlackHandler() SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayload {
+	return v.SetSlackHandler
+}
+
+// SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayload includes the requested fields of the GraphQL type SetSlackHandlerPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of SetSlackHandler
+type SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayload struct {
+	Handler SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayloadHandlerHealthCheckHandler `json:"handler"`
+}
+
+// GetHandler returns SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayload.Handler, and is useful for accessing the field via an interface.
+func (v *SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayload) GetHandler() SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayloadHandlerHealthCheckHandler {
+	return v.Handler
+}
+
+// SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayloadHandlerHealthCheckHandler includes the requested fields of the GraphQL type HealthCheckHandler.
+type SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayloadHandlerHealthCheckHandler struct {
+	// Handler name
+	Name string `json:"name"`
+	// Handler type (Slack or Pagerduty)
+	Type string `json:"type"`
+}
+
+// GetName returns SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayloadHandlerHealthCheckHandler.Name, and is useful for accessing the field via an interface.
+func (v *SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayloadHandlerHealthCheckHandler) GetName() string {
+	return v.Name
+}
+
+// GetType returns SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayloadHandlerHealthCheckHandler.Type, and is useful for accessing the field via an interface.
+func (v *SetSlackHandlerMutationSetSlackHandlerSetSlackHandlerPayloadHandlerHealthCheckHandler) GetType() string {
+	return v.Type
+}
+
+// SetVmCountMutationResponse is returned by SetVmCountMutation on success.
+type SetVmCountMutationResponse struct {
+	SetVmCount SetVmCountMutationSetVmCountSetVMCountPayload `json:"setVmCount"`
+}
+
+// GetSetVmCount returns SetVmCountMutationResponse.SetVmCount, and is useful for accessing the field via an interface.
+func (v *SetVmCountMutationResponse) GetSetVmCount() SetVmCountMutationSetVmCountSetVMCountPayload {
+	return v.SetVmCount
+}
+
+// SetVmCountMutationSetVmCountSetVMCountPayload includes the requested fields of the GraphQL type SetVMCountPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of SetVMCount
+type SetVmCountMutationSetVmCountSetVMCountPayload struct {
+	TaskGroupCounts []SetVmCountMutationSetVmCountSetVMCountPayloadTaskGroupCountsTaskGroupCount `json:"taskGroupCounts"`
+	Warnings        []string                                                                     `json:"warnings"`
+}
+
+// GetTaskGroupCounts returns SetVmCountMutationSetVmCountSetVMCountPayload.TaskGroupCounts, and is useful for accessing the field via an interface.
+func (v *SetVmCountMutationSetVmCountSetVMCountPayload) GetTaskGroupCounts() []SetVmCountMutationSetVmCountSetVMCountPayloadTaskGroupCountsTaskGroupCount {
+	return v.TaskGroupCounts
+}
+
+// GetWarnings returns SetVmCountMutationSetVmCountSetVMCountPayload.Warnings, and is useful for accessing the field via an interface.
+func (v *SetVmCountMutationSetVmCountSetVMCountPayload) GetWarnings() []string { return v.Warnings }
+
+// SetVmCountMutationSetVmCountSetVMCountPayloadTaskGroupCountsTaskGroupCount includes the requested fields of the GraphQL type TaskGroupCount.
+type SetVmCountMutationSetVmCountSetVMCountPayloadTaskGroupCountsTaskGroupCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// GetName returns SetVmCountMutationSetVmCountSetVMCountPayloadTaskGroupCountsTaskGroupCount.Name, and is useful for accessing the field via an interface.
+func (v *SetVmCountMutationSetVmCountSetVMCountPayloadTaskGroupCountsTaskGroupCount) GetName() string {
+	return v.Name
+}
+
+// GetCount returns SetVmCountMutationSetVmCountSetVMCountPayloadTaskGroupCountsTaskGroupCount.Count, and is useful for accessing the field via an interface.
+func (v *SetVmCountMutationSetVmCountSetVMCountPayloadTaskGroupCountsTaskGroupCount) GetCount() int {
+	return v.Count
+}
+
+// SetVmSizeMutationResponse is returned by SetVmSizeMutation on success.
+type SetVmSizeMutationResponse struct {
+	SetVmSize SetVmSizeMutationSetVmSizeSetVMSizePayload `json:"setVmSize"`
+}
+
+// GetSetVmSize returns SetVmSizeMutationResponse.SetVmSize, and is useful for accessing the field via an interface.
+func (v *SetVmSizeMutationResponse) GetSetVmSize() SetVmSizeMutationSetVmSizeSetVMSizePayload {
+	return v.SetVmSize
+}
+
+// SetVmSizeMutationSetVmSizeSetVMSizePayload includes the requested fields of the GraphQL type SetVMSizePayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of SetVMSize
+type SetVmSizeMutationSetVmSizeSetVMSizePayload struct {
+	// Default app vm size
+	VmSize SetVmSizeMutationSetVmSizeSetVMSizePayloadVmSizeVMSize `json:"vmSize"`
+}
+
+// GetVmSize returns SetVmSizeMutationSetVmSizeSetVMSizePayload.VmSize, and is useful for accessing the field via an interface.
+func (v *SetVmSizeMutationSetVmSizeSetVMSizePayload) GetVmSize() SetVmSizeMutationSetVmSizeSetVMSizePayloadVmSizeVMSize {
+	return v.VmSize
+}
+
+// SetVmSizeMutationSetVmSizeSetVMSizePayloadVmSizeVMSize includes the requested fields of the GraphQL type VMSize.
+type SetVmSizeMutationSetVmSizeSetVMSizePayloadVmSizeVMSize struct {
+	Name     string `json:"name"`
+	MemoryMb int    `json:"memoryMb"`
+}
+
+// GetName returns SetVmSizeMutationSetVmSizeSetVMSizePayloadVmSizeVMSize.Name, and is useful for accessing the field via an interface.
+func (v *SetVmSizeMutationSetVmSizeSetVMSizePayloadVmSizeVMSize) GetName() string { return v.Name }
+
+// GetMemoryMb returns SetVmSizeMutationSetVmSizeSetVMSizePayloadVmSizeVMSize.MemoryMb, and is useful for accessing the field via an interface.
+func (v *SetVmSizeMutationSetVmSizeSetVMSizePayloadVmSizeVMSize) GetMemoryMb() int { return v.MemoryMb }
+
+// UpdateAutoScaleConfigMutationResponse is returned by UpdateAutoScaleConfigMutation on success.
+type UpdateAutoScaleConfigMutationResponse struct {
+	UpdateAutoscaleConfig UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload `json:"updateAutoscaleConfig"`
+}
+
+// GetUpdateAutoscaleConfig returns UpdateAutoScaleConfigMutationResponse.UpdateAutoscaleConfig, and is useful for accessing the field via an interface.
+func (v *UpdateAutoScaleConfigMutationResponse) GetUpdateAutoscaleConfig() UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload {
+	return v.UpdateAutoscaleConfig
+}
+
+// UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload includes the requested fields of the GraphQL type UpdateAutoscaleConfigPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of UpdateAutoscaleConfig
+type UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload struct {
+	App UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp `json:"app"`
+}
+
+// GetApp returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload.App, and is useful for accessing the field via an interface.
+func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload) GetApp() UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp {
+	return v.App
+}
+
+// UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp includes the requested fields of the GraphQL type App.
+type UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp struct {
+	// Application status
+	Status      string                                                                                                        `json:"status"`
+	Autoscaling UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig `json:"autoscaling"`
+}
+
+// GetStatus returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp.Status, and is useful for accessing the field via an interface.
+func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp) GetStatus() string {
+	return v.Status
+}
+
+// GetAutoscaling returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp.Autoscaling, and is useful for accessing the field via an interface.
+func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp) GetAutoscaling() UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig {
+	return v.Autoscaling
+}
+
+// UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig includes the requested fields of the GraphQL type AutoscalingConfig.
+type UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig struct {
+	Regions []UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig `json:"regions"`
+}
+
+// GetRegions returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig.Regions, and is useful for accessing the field via an interface.
+func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfig) GetRegions() []UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig {
+	return v.Regions
+}
+
+// UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig includes the requested fields of the GraphQL type AutoscaleRegionConfig.
+type UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig struct {
+	// The region code
+	Code string `json:"code"`
+}
+
+// GetCode returns UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig.Code, and is useful for accessing the field via an interface.
+func (v *UpdateAutoScaleConfigMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) GetCode() string {
+	return v.Code
+}
+
+// UpdateAutoscaleSettingsMutationResponse is returned by UpdateAutoscaleSettingsMutation on success.
+type UpdateAutoscaleSettingsMutationResponse struct {
+	UpdateAutoscaleConfig UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload `json:"updateAutoscaleConfig"`
+}
+
+// GetUpdateAutoscaleConfig returns UpdateAutoscaleSettingsMutationResponse.UpdateAutoscaleConfig, and is useful for accessing the field via an interface.
+func (v *UpdateAutoscaleSettingsMutationResponse) GetUpdateAutoscaleConfig() UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload {
+	return v.UpdateAutoscaleConfig
+}
+
+// UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload includes the requested fields of the GraphQL type UpdateAutoscaleConfigPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of UpdateAutoscaleConfig
+type UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload struct {
+	App UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp `json:"app"`
+}
+
+// GetApp returns UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload.App, and is useful for accessing the field via an interface.
+func (v *UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayload) GetApp() UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp {
+	return v.App
+}
+
+// UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp includes the requested fields of the GraphQL type App.
+type UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp struct {
+	// The unique application name
+	Name string `json:"name"`
+}
+
+// GetName returns UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp.Name, and is useful for accessing the field via an interface.
+func (v *UpdateAutoscaleSettingsMutationUpdateAutoscaleConfigUpdateAutoscaleConfigPayloadApp) GetName() string {
+	return v.Name
+}
+
+// UpdateOrganizationMembershipResponse is returned by UpdateOrganizationMembership on success.
+type UpdateOrganizationMembershipResponse struct {
+	UpdateOrganizationMembership UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayload `json:"updateOrganizationMembership"`
+}
+
+// GetUpdateOrganizationMembership returns UpdateOrganizationMembershipResponse.UpdateOrganizationMembership, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationMembershipResponse) GetUpdateOrganizationMembership() UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayload {
+	return v.UpdateOrganizationMembership
+}
+
+// UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayload includes the requested fields of the GraphQL type UpdateOrganizationMembershipPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of UpdateOrganizationMembership
+type UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayload struct {
+	User UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayloadUser `json:"user"`
+}
+
+// GetUser returns UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayload.User, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayload) GetUser() UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayloadUser {
+	return v.User
+}
+
+// UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayloadUser includes the requested fields of the GraphQL type User.
+type UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayloadUser struct {
+	Id string `json:"id"`
+	// Email address for user (private)
+	Email string `json:"email"`
+}
+
+// GetId returns UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayloadUser.Id, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayloadUser) GetId() string {
+	return v.Id
+}
+
+// GetEmail returns UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayloadUser.Email, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationMembershipUpdateOrganizationMembershipUpdateOrganizationMembershipPayloadUser) GetEmail() string {
+	return v.Email
+}
+
+// ValidateWireGuardPeersResponse is returned by ValidateWireGuardPeers on success.
+type ValidateWireGuardPeersResponse struct {
+	ValidateWireGuardPeers ValidateWireGuardPeersValidateWireGuardPeersValidateWireGuardPeersPayload `json:"validateWireGuardPeers"`
+}
+
+// GetValidateWireGuardPeers returns ValidateWireGuardPeersResponse.ValidateWireGuardPeers, and is useful for accessing the field via an interface.
+func (v *ValidateWireGuardPeersResponse) GetValidateWireGuardPeers() ValidateWireGuardPeersValidateWireGuardPeersValidateWireGuardPeersPayload {
+	return v.ValidateWireGuardPeers
+}
+
+// ValidateWireGuardPeersValidateWireGuardPeersValidateWireGuardPeersPayload includes the requested fields of the GraphQL type ValidateWireGuardPeersPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of ValidateWireGuardPeers
+type ValidateWireGuardPeersValidateWireGuardPeersValidateWireGuardPeersPayload struct {
+	ValidPeerIps   []string `json:"validPeerIps"`
+	InvalidPeerIps []string `json:"invalidPeerIps"`
+}
+
+// GetValidPeerIps returns ValidateWireGuardPeersValidateWireGuardPeersValidateWireGuardPeersPayload.ValidPeerIps, and is useful for accessing the field via an interface.
+func (v *ValidateWireGuardPeersValidateWireGuardPeersValidateWireGuardPeersPayload) GetValidPeerIps() []string {
+	return v.ValidPeerIps
+}
+
+// GetInvalidPeerIps returns ValidateWireGuardPeersValidateWireGuardPeersValidateWireGuardPeersPayload.InvalidPeerIps, and is useful for accessing the field via an interface.
+func (v *ValidateWireGuardPeersValidateWireGuardPeersValidateWireGuardPeersPayload) GetInvalidPeerIps() []string {
+	return v.InvalidPeerIps
+}
+
+// ViewerQueryResponse is returned by ViewerQuery on success.
+type ViewerQueryResponse struct {
+	Viewer ViewerQueryViewerUser `json:"viewer"`
+}
+
+// GetViewer returns ViewerQueryResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *ViewerQueryResponse) GetViewer() ViewerQueryViewerUser { return v.Viewer }
+
+// ViewerQueryViewerUser includes the requested fields of the GraphQL type User.
+type ViewerQueryViewerUser struct {
+	Id string `json:"id"`
+	// Email address for user (private)
+	Email string `json:"email"`
+	// Display / full name for user (private)
+	Name string `json:"name"`
+}
+
+// GetId returns ViewerQueryViewerUser.Id, and is useful for accessing the field via an interface.
+func (v *ViewerQueryViewerUser) GetId() string { return v.Id }
+
+// GetEmail returns ViewerQueryViewerUser.Email, and is useful for accessing the field via an interface.
+func (v *ViewerQueryViewerUser) GetEmail() string { return v.Email }
+
+// GetName returns ViewerQueryViewerUser.Name, and is useful for accessing the field via an interface.
+func (v *ViewerQueryViewerUser) GetName() string { return v.Name }
+
+// VolumeQueryApp includes the requested fields of the GraphQL type App.
+type VolumeQueryApp struct {
+	Volume VolumeQueryAppVolume `json:"volume"`
+}
+
+// GetVolume returns VolumeQueryApp.Volume, and is useful for accessing the field via an interface.
+func (v *VolumeQueryApp) GetVolume() VolumeQueryAppVolume { return v.Volume }
+
+// VolumeQueryAppVolume includes the requested fields of the GraphQL type Volume.
+type VolumeQueryAppVolume struct {
+	Name       string `json:"name"`
+	Region     string `json:"region"`
+	Id         string `json:"id"`
+	InternalId string `json:"internalId"`
+	SizeGb     int    `json:"sizeGb"`
+}
+
+// GetName returns VolumeQueryAppVolume.Name, and is useful for accessing the field via an interface.
+func (v *VolumeQueryAppVolume) GetName() string { return v.Name }
+
+// GetRegion returns VolumeQueryAppVolume.Region, and is useful for accessing the field via an interface.
+func (v *VolumeQueryAppVolume) GetRegion() string { return v.Region }
+
+// GetId returns VolumeQueryAppVolume.Id, and is useful for accessing the field via an interface.
+func (v *VolumeQueryAppVolume) GetId() string { return v.Id }
+
+// GetInternalId returns VolumeQueryAppVolume.InternalId, and is useful for accessing the field via an interface.
+func (v *VolumeQueryAppVolume) GetInternalId() string { return v.InternalId }
+
+// GetSizeGb returns VolumeQueryAppVolume.SizeGb, and is useful for accessing the field via an interface.
+func (v *VolumeQueryAppVolume) GetSizeGb() int { return v.SizeGb }
+
+// VolumeQueryResponse is returned by VolumeQuery on success.
+type VolumeQueryResponse struct {
+	// Find an app by name
+	App VolumeQueryApp `json:"app"`
+}
+
+// GetApp returns VolumeQueryResponse.App, and is useful for accessing the field via an interface.
+func (v *VolumeQueryResponse) GetApp() VolumeQueryApp { return v.App }
+
+// WireguardPeerQueryOrganization includes the requested fields of the GraphQL type Organization.
+type WireguardPeerQueryOrganization struct {
+	// Find a peer by name
+	WireGuardPeer WireguardPeerQueryOrganizationWireGuardPeer `json:"wireGuardPeer"`
+}
+
+// GetWireGuardPeer returns WireguardPeerQueryOrganization.WireGuardPeer, and is useful for accessing the field via an interface.
+func (v *WireguardPeerQueryOrganization) GetWireGuardPeer() WireguardPeerQueryOrganizationWireGuardPeer {
+	return v.WireGuardPeer
+}
+
+// WireguardPeerQueryOrganizationWireGuardPeer includes the requested fields of the GraphQL type WireGuardPeer.
+type WireguardPeerQueryOrganizationWireGuardPeer struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Network string `json:"network"`
+	Peerip  string `json:"peerip"`
+	Pubkey  string `json:"pubkey"`
+	Region  string `json:"region"`
+}
+
+// GetId returns WireguardPeerQueryOrganizationWireGuardPeer.Id, and is useful for accessing the field via an interface.
+func (v *WireguardPeerQueryOrganizationWireGuardPeer) GetId() string { return v.Id }
+
+// GetName returns WireguardPeerQueryOrganizationWireGuardPeer.Name, and is useful for accessing the field via an interface.
+func (v *WireguardPeerQueryOrganizationWireGuardPeer) GetName() string { return v.Name }
+
+// GetNetwork returns WireguardPeerQueryOrganizationWireGuardPeer.Network, and is useful for accessing the field via an interface.
+func (v *WireguardPeerQueryOrganizationWireGuardPeer) GetNetwork() string { return v.Network }
+
+// GetPeerip returns WireguardPeerQueryOrganizationWireGuardPeer.Peerip, and is useful for accessing the field via an interface.
+func (v *WireguardPeerQueryOrganizationWireGuardPeer) GetPeerip() string { return v.Peerip }
+
+// GetPubkey returns WireguardPeerQueryOrganizationWireGuardPeer.Pubkey, and is useful for accessing the field via an interface.
+func (v *WireguardPeerQueryOrganizationWireGuardPeer) GetPubkey() string { return v.Pubkey }
+
+// GetRegion returns WireguardPeerQueryOrganizationWireGuardPeer.Region, and is useful for accessing the field via an interface.
+func (v *WireguardPeerQueryOrganizationWireGuardPeer) GetRegion() string { return v.Region }
+
+// WireguardPeerQueryResponse is returned by WireguardPeerQuery on success.
+type WireguardPeerQueryResponse struct {
+	// Find an organization by ID
+	Organization WireguardPeerQueryOrganization `json:"organization"`
+}
+
+// GetOrganization returns WireguardPeerQueryResponse.Organization, and is useful for accessing the field via an interface.
+func (v *WireguardPeerQueryResponse) GetOrganization() WireguardPeerQueryOrganization {
+	return v.Organization
+}
+
+// WireguardPeersQueryOrganization includes the requested fields of the GraphQL type Organization.
+type WireguardPeersQueryOrganization struct {
+	WireGuardPeers WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnection `json:"wireGuardPeers"`
+}
+
+// GetWireGuardPeers returns WireguardPeersQueryOrganization.WireGuardPeers, and is useful for accessing the field via an interface.
+func (v *WireguardPeersQueryOrganization) GetWireGuardPeers() WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnection {
+	return v.WireGuardPeers
+}
+
+// WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnection includes the requested fields of the GraphQL type WireGuardPeerConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for WireGuardPeer.
+type WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnection struct {
+	// A list of nodes.
+	Nodes []WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnectionNodesWireGuardPeer `json:"nodes"`
+}
+
+// GetNodes returns WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnection) GetNodes() []WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnectionNodesWireGuardPeer {
+	return v.Nodes
+}
+
+// WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnectionNodesWireGuardPeer includes the requested fields of the GraphQL type WireGuardPeer.
+type WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnectionNodesWireGuardPeer struct {
+	Name   string `json:"name"`
+	Peerip string `json:"peerip"`
+}
+
+// GetName returns WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnectionNodesWireGuardPeer.Name, and is useful for accessing the field via an interface.
+func (v *WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnectionNodesWireGuardPeer) GetName() string {
+	return v.Name
+}
+
+// GetPeerip returns WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnectionNodesWireGuardPeer.Peerip, and is useful for accessing the field via an interface.
+func (v *WireguardPeersQueryOrganizationWireGuardPeersWireGuardPeerConnectionNodesWireGuardPeer) GetPeerip() string {
+	return v.Peerip
+}
+
+// WireguardPeersQueryResponse is returned by WireguardPeersQuery on success.
+type WireguardPeersQueryResponse struct {
+	// Find an organization by ID
+	Organization WireguardPeersQueryOrganization `json:"organization"`
+}
+
+// GetOrganization returns WireguardPeersQueryResponse.Organization, and is useful for accessing the field via an interface.
+func (v *WireguardPeersQueryResponse) GetOrganization() WireguardPeersQueryOrganization {
+	return v.Organization
+}
+
+// __AddCertificateInput is used internally by genqlient
+type __AddCertificateInput struct {
+	App      string `json:"app"`
+	Hostname string `json:"hostname"`
+}
+
+// GetApp returns __AddCertificateInput.App, and is useful for accessing the field via an interface.
+func (v *__AddCertificateInput) GetApp() string { return v.App }
+
+// GetHostname returns __AddCertificateInput.Hostname, and is useful for accessing the field via an interface.
+func (v *__AddCertificateInput) GetHostname() string { return v.Hostname }
+
+// __AddWireguardPeerInput is used internally by genqlient
+type __AddWireguardPeerInput struct {
+	Input AddWireGuardPeerInput `json:"input"`
+}
+
+// GetInput returns __AddWireguardPeerInput.Input, and is useful for accessing the field via an interface.
+func (v *__AddWireguardPeerInput) GetInput() AddWireGuardPeerInput { return v.Input }
+
+// __AllocateIpAddressInput is used internally by genqlient
+type __AllocateIpAddressInput struct {
+	App      string        `json:"app"`
+	Region   string        `json:"region"`
+	AddrType IPAddressType `json:"addrType"`
+}
+
+// GetApp returns __AllocateIpAddressInput.App, and is useful for accessing the field via an interface.
+func (v *__AllocateIpAddressInput) GetApp() string { return v.App }
+
+// GetRegion returns __AllocateIpAddressInput.Region, and is useful for accessing the field via an interface.
+func (v *__AllocateIpAddressInput) GetRegion() string { return v.Region }
+
+// GetAddrType returns __AllocateIpAddressInput.AddrType, and is useful for accessing the field via an interface.
+func (v *__AllocateIpAddressInput) GetAddrType() IPAddressType { return v.AddrType }
+
+// __AppAutoscalingQueryInput is used internally by genqlient
+type __AppAutoscalingQueryInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __AppAutoscalingQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__AppAutoscalingQueryInput) GetName() string { return v.Name }
+
+// __AppConfigQueryInput is used internally by genqlient
+type __AppConfigQueryInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __AppConfigQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__AppConfigQueryInput) GetName() string { return v.Name }
+
+// __AppRegionsQueryInput is used internally by genqlient
+type __AppRegionsQueryInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __AppRegionsQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__AppRegionsQueryInput) GetName() string { return v.Name }
+
+// __AppScaleQueryInput is used internally by genqlient
+type __AppScaleQueryInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __AppScaleQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__AppScaleQueryInput) GetName() string { return v.Name }
+
+// __AttachPostgresClusterMutationInput is used internally by genqlient
+type __AttachPostgresClusterMutationInput struct {
+	PostgresClusterAppId string `json:"postgresClusterAppId"`
+	AppId                string `json:"appId"`
+	DatabaseName         string `json:"databaseName,omitempty"`
+	DatabaseUser         string `json:"databaseUser,omitempty"`
+	VariableName         string `json:"variableName,omitempty"`
+}
+
+// GetPostgresClusterAppId returns __AttachPostgresClusterMutationInput.PostgresClusterAppId, and is useful for accessing the field via an interface.
+func (v *__AttachPostgresClusterMutationInput) GetPostgresClusterAppId() string {
+	return v.PostgresClusterAppId
+}
+
+// GetAppId returns __AttachPostgresClusterMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__AttachPostgresClusterMutationInput) GetAppId() string { return v.AppId }
+
+// GetDatabaseName returns __AttachPostgresClusterMutationInput.DatabaseName, and is useful for accessing the field via an interface.
+func (v *__AttachPostgresClusterMutationInput) GetDatabaseName() string { return v.DatabaseName }
+
+// GetDatabaseUser returns __AttachPostgresClusterMutationInput.DatabaseUser, and is useful for accessing the field via an interface.
+func (v *__AttachPostgresClusterMutationInput) GetDatabaseUser() string { return v.DatabaseUser }
+
+// GetVariableName returns __AttachPostgresClusterMutationInput.VariableName, and is useful for accessing the field via an interface.
+func (v *__AttachPostgresClusterMutationInput) GetVariableName() string { return v.VariableName }
+
+// __CheckJobQueryInput is used internally by genqlient
+type __CheckJobQueryInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __CheckJobQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__CheckJobQueryInput) GetId() string { return v.Id }
+
+// __CheckJobRunsQueryInput is used internally by genqlient
+type __CheckJobRunsQueryInput struct {
+	Id   string `json:"id"`
+	Last int    `json:"last"`
+}
+
+// GetId returns __CheckJobRunsQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__CheckJobRunsQueryInput) GetId() string { return v.Id }
+
+// GetLast returns __CheckJobRunsQueryInput.Last, and is useful for accessing the field via an interface.
+func (v *__CheckJobRunsQueryInput) GetLast() int { return v.Last }
+
+// __ConfigureRegionsMutationInput is used internally by genqlient
+type __ConfigureRegionsMutationInput struct {
+	AppId         string   `json:"appId"`
+	AllowRegions  []string `json:"allowRegions"`
+	DenyRegions   []string `json:"denyRegions"`
+	BackupRegions []string `json:"backupRegions"`
+	Group         string   `json:"group,omitempty"`
+}
+
+// GetAppId returns __ConfigureRegionsMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__ConfigureRegionsMutationInput) GetAppId() string { return v.AppId }
+
+// GetAllowRegions returns __ConfigureRegionsMutationInput.AllowRegions, and is useful for accessing the field via an interface.
+func (v *__ConfigureRegionsMutationInput) GetAllowRegions() []string { return v.AllowRegions }
+
+// GetDenyRegions returns __ConfigureRegionsMutationInput.DenyRegions, and is useful for accessing the field via an interface.
+func (v *__ConfigureRegionsMutationInput) GetDenyRegions() []string { return v.DenyRegions }
+
+// GetBackupRegions returns __ConfigureRegionsMutationInput.BackupRegions, and is useful for accessing the field via an interface.
+func (v *__ConfigureRegionsMutationInput) GetBackupRegions() []string { return v.BackupRegions }
+
+// GetGroup returns __ConfigureRegionsMutationInput.Group, and is useful for accessing the field via an interface.
+func (v *__ConfigureRegionsMutationInput) GetGroup() string { return v.Group }
+
+// __CreateAppMutationInput is used internally by genqlient
+type __CreateAppMutationInput struct {
+	Name            string `json:"name"`
+	OrganizationId  string `json:"organizationId"`
+	PreferredRegion string `json:"preferredRegion"`
+	Network         string `json:"network"`
+}
+
+// GetName returns __CreateAppMutationInput.Name, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationInput) GetName() string { return v.Name }
+
+// GetOrganizationId returns __CreateAppMutationInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetPreferredRegion returns __CreateAppMutationInput.PreferredRegion, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationInput) GetPreferredRegion() string { return v.PreferredRegion }
+
+// GetNetwork returns __CreateAppMutationInput.Network, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationInput) GetNetwork() string { return v.Network }
+
+// __CreateAppMutationWithAutoscaleConfigInput is used internally by genqlient
+type __CreateAppMutationWithAutoscaleConfigInput struct {
+	Name            string                       `json:"name"`
+	Id              string                       `json:"id"`
+	OrganizationId  string                       `json:"organizationId"`
+	PreferredRegion string                       `json:"preferredRegion"`
+	Network         string                       `json:"network"`
+	Regions         []AutoscaleRegionConfigInput `json:"regions"`
+}
+
+// GetName returns __CreateAppMutationWithAutoscaleConfigInput.Name, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationWithAutoscaleConfigInput) GetName() string { return v.Name }
+
+// GetId returns __CreateAppMutationWithAutoscaleConfigInput.Id, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationWithAutoscaleConfigInput) GetId() string { return v.Id }
+
+// GetOrganizationId returns __CreateAppMutationWithAutoscaleConfigInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationWithAutoscaleConfigInput) GetOrganizationId() string {
+	return v.OrganizationId
+}
+
+// GetPreferredRegion returns __CreateAppMutationWithAutoscaleConfigInput.PreferredRegion, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationWithAutoscaleConfigInput) GetPreferredRegion() string {
+	return v.PreferredRegion
+}
+
+// GetNetwork returns __CreateAppMutationWithAutoscaleConfigInput.Network, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationWithAutoscaleConfigInput) GetNetwork() string { return v.Network }
+
+// GetRegions returns __CreateAppMutationWithAutoscaleConfigInput.Regions, and is useful for accessing the field via an interface.
+func (v *__CreateAppMutationWithAutoscaleConfigInput) GetRegions() []AutoscaleRegionConfigInput {
+	return v.Regions
+}
+
+// __CreateCheckJobMutationInput is used internally by genqlient
+type __CreateCheckJobMutationInput struct {
+	OrganizationId string        `json:"organizationId"`
+	Url            string        `json:"url"`
+	Locations      []string      `json:"locations"`
+	Verb           CheckHTTPVerb `json:"verb"`
+	Headers        []string      `json:"headers"`
+}
+
+// GetOrganizationId returns __CreateCheckJobMutationInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__CreateCheckJobMutationInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetUrl returns __CreateCheckJobMutationInput.Url, and is useful for accessing the field via an interface.
+func (v *__CreateCheckJobMutationInput) GetUrl() string { return v.Url }
+
+// GetLocations returns __CreateCheckJobMutationInput.Locations, and is useful for accessing the field via an interface.
+func (v *__CreateCheckJobMutationInput) GetLocations() []string { return v.Locations }
+
+// GetVerb returns __CreateCheckJobMutationInput.Verb, and is useful for accessing the field via an interface.
+func (v *__CreateCheckJobMutationInput) GetVerb() CheckHTTPVerb { return v.Verb }
+
+// GetHeaders returns __CreateCheckJobMutationInput.Headers, and is useful for accessing the field via an interface.
+func (v *__CreateCheckJobMutationInput) GetHeaders() []string { return v.Headers }
+
+// __CreateCheckJobRunMutationInput is used internally by genqlient
+type __CreateCheckJobRunMutationInput struct {
+	CheckJobId string `json:"checkJobId"`
+}
+
+// GetCheckJobId returns __CreateCheckJobRunMutationInput.CheckJobId, and is useful for accessing the field via an interface.
+func (v *__CreateCheckJobRunMutationInput) GetCheckJobId() string { return v.CheckJobId }
+
+// __CreateDelegatedWireGuardTokenInput is used internally by genqlient
+type __CreateDelegatedWireGuardTokenInput struct {
+	OrganizationId string `json:"organizationId"`
+	Name           string `json:"name"`
+}
+
+// GetOrganizationId returns __CreateDelegatedWireGuardTokenInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__CreateDelegatedWireGuardTokenInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetName returns __CreateDelegatedWireGuardTokenInput.Name, and is useful for accessing the field via an interface.
+func (v *__CreateDelegatedWireGuardTokenInput) GetName() string { return v.Name }
+
+// __CreateDoctorReportInput is used internally by genqlient
+type __CreateDoctorReportInput struct {
+	Data interface{} `json:"data"`
+}
+
+// GetData returns __CreateDoctorReportInput.Data, and is useful for accessing the field via an interface.
+func (v *__CreateDoctorReportInput) GetData() interface{} { return v.Data }
+
+// __CreateOrganizationInput is used internally by genqlient
+type __CreateOrganizationInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __CreateOrganizationInput.Name, and is useful for accessing the field via an interface.
+func (v *__CreateOrganizationInput) GetName() string { return v.Name }
+
+// __CreateOrganizationInvitationInput is used internally by genqlient
+type __CreateOrganizationInvitationInput struct {
+	OrganizationId string `json:"organizationId"`
+	Email          string `json:"email"`
+}
+
+// GetOrganizationId returns __CreateOrganizationInvitationInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__CreateOrganizationInvitationInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetEmail returns __CreateOrganizationInvitationInput.Email, and is useful for accessing the field via an interface.
+func (v *__CreateOrganizationInvitationInput) GetEmail() string { return v.Email }
+
+// __CreatePostgresClusterDatabaseMutationInput is used internally by genqlient
+type __CreatePostgresClusterDatabaseMutationInput struct {
+	AppName      string `json:"appName"`
+	DatabaseName string `json:"databaseName"`
+}
+
+// GetAppName returns __CreatePostgresClusterDatabaseMutationInput.AppName, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterDatabaseMutationInput) GetAppName() string { return v.AppName }
+
+// GetDatabaseName returns __CreatePostgresClusterDatabaseMutationInput.DatabaseName, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterDatabaseMutationInput) GetDatabaseName() string {
+	return v.DatabaseName
+}
+
+// __CreatePostgresClusterInput is used internally by genqlient
+type __CreatePostgresClusterInput struct {
+	Name       string `json:"name"`
+	Orgid      string `json:"orgid"`
+	Region     string `json:"region"`
+	Password   string `json:"password"`
+	Vmsize     string `json:"vmsize"`
+	Volumesize int    `json:"volumesize"`
+	Count      int    `json:"count"`
+	Imageref   string `json:"imageref"`
+}
+
+// GetName returns __CreatePostgresClusterInput.Name, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterInput) GetName() string { return v.Name }
+
+// GetOrgid returns __CreatePostgresClusterInput.Orgid, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterInput) GetOrgid() string { return v.Orgid }
+
+// GetRegion returns __CreatePostgresClusterInput.Region, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterInput) GetRegion() string { return v.Region }
+
+// GetPassword returns __CreatePostgresClusterInput.Password, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterInput) GetPassword() string { return v.Password }
+
+// GetVmsize returns __CreatePostgresClusterInput.Vmsize, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterInput) GetVmsize() string { return v.Vmsize }
+
+// GetVolumesize returns __CreatePostgresClusterInput.Volumesize, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterInput) GetVolumesize() int { return v.Volumesize }
+
+// GetCount returns __CreatePostgresClusterInput.Count, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterInput) GetCount() int { return v.Count }
+
+// GetImageref returns __CreatePostgresClusterInput.Imageref, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterInput) GetImageref() string { return v.Imageref }
+
+// __CreatePostgresClusterUserMutationInput is used internally by genqlient
+type __CreatePostgresClusterUserMutationInput struct {
+	AppName   string `json:"appName"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	Superuser bool   `json:"superuser"`
+}
+
+// GetAppName returns __CreatePostgresClusterUserMutationInput.AppName, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterUserMutationInput) GetAppName() string { return v.AppName }
+
+// GetUsername returns __CreatePostgresClusterUserMutationInput.Username, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterUserMutationInput) GetUsername() string { return v.Username }
+
+// GetPassword returns __CreatePostgresClusterUserMutationInput.Password, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterUserMutationInput) GetPassword() string { return v.Password }
+
+// GetSuperuser returns __CreatePostgresClusterUserMutationInput.Superuser, and is useful for accessing the field via an interface.
+func (v *__CreatePostgresClusterUserMutationInput) GetSuperuser() bool { return v.Superuser }
+
+// __CreateVolumeInput is used internally by genqlient
+type __CreateVolumeInput struct {
+	App    string `json:"app"`
+	Name   string `json:"name"`
+	Region string `json:"region"`
+	SizeGb int    `json:"sizeGb"`
+}
+
+// GetApp returns __CreateVolumeInput.App, and is useful for accessing the field via an interface.
+func (v *__CreateVolumeInput) GetApp() string { return v.App }
+
+// GetName returns __CreateVolumeInput.Name, and is useful for accessing the field via an interface.
+func (v *__CreateVolumeInput) GetName() string { return v.Name }
+
+// GetRegion returns __CreateVolumeInput.Region, and is useful for accessing the field via an interface.
+func (v *__CreateVolumeInput) GetRegion() string { return v.Region }
+
+// GetSizeGb returns __CreateVolumeInput.SizeGb, and is useful for accessing the field via an interface.
+func (v *__CreateVolumeInput) GetSizeGb() int { return v.SizeGb }
+
+// __CurrentReleaseQueryInput is used internally by genqlient
+type __CurrentReleaseQueryInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __CurrentReleaseQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__CurrentReleaseQueryInput) GetName() string { return v.Name }
+
+// __DelegatedWireGuardTokensQueryInput is used internally by genqlient
+type __DelegatedWireGuardTokensQueryInput struct {
+	Org string `json:"org"`
+}
+
+// GetOrg returns __DelegatedWireGuardTokensQueryInput.Org, and is useful for accessing the field via an interface.
+func (v *__DelegatedWireGuardTokensQueryInput) GetOrg() string { return v.Org }
+
+// __DeleteAppMutationInput is used internally by genqlient
+type __DeleteAppMutationInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __DeleteAppMutationInput.Name, and is useful for accessing the field via an interface.
+func (v *__DeleteAppMutationInput) GetName() string { return v.Name }
+
+// __DeleteCertificateInput is used internally by genqlient
+type __DeleteCertificateInput struct {
+	App      string `json:"app"`
+	Hostname string `json:"hostname"`
+}
+
+// GetApp returns __DeleteCertificateInput.App, and is useful for accessing the field via an interface.
+func (v *__DeleteCertificateInput) GetApp() string { return v.App }
+
+// GetHostname returns __DeleteCertificateInput.Hostname, and is useful for accessing the field via an interface.
+func (v *__DeleteCertificateInput) GetHostname() string { return v.Hostname }
+
+// __DeleteDelegatedWireGuardTokenInput is used internally by genqlient
+type __DeleteDelegatedWireGuardTokenInput struct {
+	OrganizationId string `json:"organizationId"`
+	Name           string `json:"name"`
+}
+
+// GetOrganizationId returns __DeleteDelegatedWireGuardTokenInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__DeleteDelegatedWireGuardTokenInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetName returns __DeleteDelegatedWireGuardTokenInput.Name, and is useful for accessing the field via an interface.
+func (v *__DeleteDelegatedWireGuardTokenInput) GetName() string { return v.Name }
+
+// __DeleteHealthCheckHandlerMutationInput is used internally by genqlient
+type __DeleteHealthCheckHandlerMutationInput struct {
+	OrganizationId string `json:"organizationId"`
+	Name           string `json:"name"`
+}
+
+// GetOrganizationId returns __DeleteHealthCheckHandlerMutationInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__DeleteHealthCheckHandlerMutationInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetName returns __DeleteHealthCheckHandlerMutationInput.Name, and is useful for accessing the field via an interface.
+func (v *__DeleteHealthCheckHandlerMutationInput) GetName() string { return v.Name }
+
+// __DeleteOrganizationInput is used internally by genqlient
+type __DeleteOrganizationInput struct {
+	OrganizationId string `json:"organizationId"`
+}
+
+// GetOrganizationId returns __DeleteOrganizationInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__DeleteOrganizationInput) GetOrganizationId() string { return v.OrganizationId }
+
+// __DeleteOrganizationInvitationInput is used internally by genqlient
+type __DeleteOrganizationInvitationInput struct {
+	InvitationId string `json:"invitationId"`
+}
+
+// GetInvitationId returns __DeleteOrganizationInvitationInput.InvitationId, and is useful for accessing the field via an interface.
+func (v *__DeleteOrganizationInvitationInput) GetInvitationId() string { return v.InvitationId }
+
+// __DeleteOrganizationMembershipInput is used internally by genqlient
+type __DeleteOrganizationMembershipInput struct {
+	OrganizationId string `json:"organizationId"`
+	UserId         string `json:"userId"`
+}
+
+// GetOrganizationId returns __DeleteOrganizationMembershipInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__DeleteOrganizationMembershipInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetUserId returns __DeleteOrganizationMembershipInput.UserId, and is useful for accessing the field via an interface.
+func (v *__DeleteOrganizationMembershipInput) GetUserId() string { return v.UserId }
+
+// __DeleteVolumeInput is used internally by genqlient
+type __DeleteVolumeInput struct {
+	Volume string `json:"volume"`
+}
+
+// GetVolume returns __DeleteVolumeInput.Volume, and is useful for accessing the field via an interface.
+func (v *__DeleteVolumeInput) GetVolume() string { return v.Volume }
+
+// __DeployImageMutationInput is used internally by genqlient
+type __DeployImageMutationInput struct {
+	AppId      string             `json:"appId"`
+	Image      string             `json:"image"`
+	Definition interface{}        `json:"definition,omitempty"`
+	Strategy   DeploymentStrategy `json:"strategy,omitempty"`
+}
+
+// GetAppId returns __DeployImageMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__DeployImageMutationInput) GetAppId() string { return v.AppId }
+
+// GetImage returns __DeployImageMutationInput.Image, and is useful for accessing the field via an interface.
+func (v *__DeployImageMutationInput) GetImage() string { return v.Image }
+
+// GetDefinition returns __DeployImageMutationInput.Definition, and is useful for accessing the field via an interface.
+func (v *__DeployImageMutationInput) GetDefinition() interface{} { return v.Definition }
+
+// GetStrategy returns __DeployImageMutationInput.Strategy, and is useful for accessing the field via an interface.
+func (v *__DeployImageMutationInput) GetStrategy() DeploymentStrategy { return v.Strategy }
+
+// __DeploymentStatusQueryInput is used internally by genqlient
+type __DeploymentStatusQueryInput struct {
+	Name         string `json:"name"`
+	EvaluationId string `json:"evaluationId"`
+}
+
+// GetName returns __DeploymentStatusQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__DeploymentStatusQueryInput) GetName() string { return v.Name }
+
+// GetEvaluationId returns __DeploymentStatusQueryInput.EvaluationId, and is useful for accessing the field via an interface.
+func (v *__DeploymentStatusQueryInput) GetEvaluationId() string { return v.EvaluationId }
+
+// __DetachPostgresClusterMutationInput is used internally by genqlient
+type __DetachPostgresClusterMutationInput struct {
+	PostgresClusterAppId        string `json:"postgresClusterAppId"`
+	AppId                       string `json:"appId"`
+	PostgresClusterAttachmentId string `json:"postgresClusterAttachmentId"`
+}
+
+// GetPostgresClusterAppId returns __DetachPostgresClusterMutationInput.PostgresClusterAppId, and is useful for accessing the field via an interface.
+func (v *__DetachPostgresClusterMutationInput) GetPostgresClusterAppId() string {
+	return v.PostgresClusterAppId
+}
+
+// GetAppId returns __DetachPostgresClusterMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__DetachPostgresClusterMutationInput) GetAppId() string { return v.AppId }
+
+// GetPostgresClusterAttachmentId returns __DetachPostgresClusterMutationInput.PostgresClusterAttachmentId, and is useful for accessing the field via an interface.
+func (v *__DetachPostgresClusterMutationInput) GetPostgresClusterAttachmentId() string {
+	return v.PostgresClusterAttachmentId
+}
+
+// __GetCertificateInput is used internally by genqlient
+type __GetCertificateInput struct {
+	App      string `json:"app"`
+	Hostname string `json:"hostname"`
+}
+
+// GetApp returns __GetCertificateInput.App, and is useful for accessing the field via an interface.
+func (v *__GetCertificateInput) GetApp() string { return v.App }
+
+// GetHostname returns __GetCertificateInput.Hostname, and is useful for accessing the field via an interface.
+func (v *__GetCertificateInput) GetHostname() string { return v.Hostname }
+
+// __GetFullAppInput is used internally by genqlient
+type __GetFullAppInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __GetFullAppInput.Name, and is useful for accessing the field via an interface.
+func (v *__GetFullAppInput) GetName() string { return v.Name }
+
+// __GetFullOrganizationInput is used internally by genqlient
+type __GetFullOrganizationInput struct {
+	Id   string `json:"id,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
+
+// GetId returns __GetFullOrganizationInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetFullOrganizationInput) GetId() string { return v.Id }
+
+// GetSlug returns __GetFullOrganizationInput.Slug, and is useful for accessing the field via an interface.
+func (v *__GetFullOrganizationInput) GetSlug() string { return v.Slug }
+
+// __GrantPostgresClusterUserAccessMutationInput is used internally by genqlient
+type __GrantPostgresClusterUserAccessMutationInput struct {
+	AppName      string `json:"appName"`
+	Username     string `json:"username"`
+	DatabaseName string `json:"databaseName"`
+}
+
+// GetAppName returns __GrantPostgresClusterUserAccessMutationInput.AppName, and is useful for accessing the field via an interface.
+func (v *__GrantPostgresClusterUserAccessMutationInput) GetAppName() string { return v.AppName }
+
+// GetUsername returns __GrantPostgresClusterUserAccessMutationInput.Username, and is useful for accessing the field via an interface.
+func (v *__GrantPostgresClusterUserAccessMutationInput) GetUsername() string { return v.Username }
+
+// GetDatabaseName returns __GrantPostgresClusterUserAccessMutationInput.DatabaseName, and is useful for accessing the field via an interface.
+func (v *__GrantPostgresClusterUserAccessMutationInput) GetDatabaseName() string {
+	return v.DatabaseName
+}
+
+// __HealthCheckHandlersQueryInput is used internally by genqlient
+type __HealthCheckHandlersQueryInput struct {
+	Org string `json:"org"`
+}
+
+// GetOrg returns __HealthCheckHandlersQueryInput.Org, and is useful for accessing the field via an interface.
+func (v *__HealthCheckHandlersQueryInput) GetOrg() string { return v.Org }
+
+// __IpAddressQueryInput is used internally by genqlient
+type __IpAddressQueryInput struct {
+	App  string `json:"app"`
+	Addr string `json:"addr"`
+}
+
+// GetApp returns __IpAddressQueryInput.App, and is useful for accessing the field via an interface.
+func (v *__IpAddressQueryInput) GetApp() string { return v.App }
+
+// GetAddr returns __IpAddressQueryInput.Addr, and is useful for accessing the field via an interface.
+func (v *__IpAddressQueryInput) GetAddr() string { return v.Addr }
+
+// __MoveAppMutationInput is used internally by genqlient
+type __MoveAppMutationInput struct {
+	AppId          string `json:"appId"`
+	OrganizationId string `json:"organizationId"`
+}
+
+// GetAppId returns __MoveAppMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__MoveAppMutationInput) GetAppId() string { return v.AppId }
+
+// GetOrganizationId returns __MoveAppMutationInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__MoveAppMutationInput) GetOrganizationId() string { return v.OrganizationId }
+
+// __OrgInvitationsQueryInput is used internally by genqlient
+type __OrgInvitationsQueryInput struct {
+	Id    string `json:"id"`
+	After string `json:"after"`
+}
+
+// GetId returns __OrgInvitationsQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__OrgInvitationsQueryInput) GetId() string { return v.Id }
+
+// GetAfter returns __OrgInvitationsQueryInput.After, and is useful for accessing the field via an interface.
+func (v *__OrgInvitationsQueryInput) GetAfter() string { return v.After }
+
+// __OrgMembersQueryInput is used internally by genqlient
+type __OrgMembersQueryInput struct {
+	Id    string `json:"id"`
+	After string `json:"after"`
+}
+
+// GetId returns __OrgMembersQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__OrgMembersQueryInput) GetId() string { return v.Id }
+
+// GetAfter returns __OrgMembersQueryInput.After, and is useful for accessing the field via an interface.
+func (v *__OrgMembersQueryInput) GetAfter() string { return v.After }
+
+// __OrgQueryInput is used internally by genqlient
+type __OrgQueryInput struct {
+	Id   string `json:"id,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
+
+// GetId returns __OrgQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__OrgQueryInput) GetId() string { return v.Id }
+
+// GetSlug returns __OrgQueryInput.Slug, and is useful for accessing the field via an interface.
+func (v *__OrgQueryInput) GetSlug() string { return v.Slug }
+
+// __OrgsQueryInput is used internally by genqlient
+type __OrgsQueryInput struct {
+	After string `json:"after,omitempty"`
+}
+
+// GetAfter returns __OrgsQueryInput.After, and is useful for accessing the field via an interface.
+func (v *__OrgsQueryInput) GetAfter() string { return v.After }
+
+// __ParseConfigQueryInput is used internally by genqlient
+type __ParseConfigQueryInput struct {
+	Name       string      `json:"name"`
+	Definition interface{} `json:"definition"`
+}
+
+// GetName returns __ParseConfigQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__ParseConfigQueryInput) GetName() string { return v.Name }
+
+// GetDefinition returns __ParseConfigQueryInput.Definition, and is useful for accessing the field via an interface.
+func (v *__ParseConfigQueryInput) GetDefinition() interface{} { return v.Definition }
+
+// __PauseAppMutationInput is used internally by genqlient
+type __PauseAppMutationInput struct {
+	AppId string `json:"appId"`
+}
+
+// GetAppId returns __PauseAppMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__PauseAppMutationInput) GetAppId() string { return v.AppId }
+
+// __PostgresAttachmentAppsQueryInput is used internally by genqlient
+type __PostgresAttachmentAppsQueryInput struct {
+	PostgresApp string `json:"postgresApp"`
+	App         string `json:"app"`
+}
+
+// GetPostgresApp returns __PostgresAttachmentAppsQueryInput.PostgresApp, and is useful for accessing the field via an interface.
+func (v *__PostgresAttachmentAppsQueryInput) GetPostgresApp() string { return v.PostgresApp }
+
+// GetApp returns __PostgresAttachmentAppsQueryInput.App, and is useful for accessing the field via an interface.
+func (v *__PostgresAttachmentAppsQueryInput) GetApp() string { return v.App }
+
+// __PostgresAttachmentsQueryInput is used internally by genqlient
+type __PostgresAttachmentsQueryInput struct {
+	AppName         string `json:"appName"`
+	PostgresAppName string `json:"postgresAppName"`
+}
+
+// GetAppName returns __PostgresAttachmentsQueryInput.AppName, and is useful for accessing the field via an interface.
+func (v *__PostgresAttachmentsQueryInput) GetAppName() string { return v.AppName }
+
+// GetPostgresAppName returns __PostgresAttachmentsQueryInput.PostgresAppName, and is useful for accessing the field via an interface.
+func (v *__PostgresAttachmentsQueryInput) GetPostgresAppName() string { return v.PostgresAppName }
+
+// __PostgresClusterRoleQueryInput is used internally by genqlient
+type __PostgresClusterRoleQueryInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __PostgresClusterRoleQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__PostgresClusterRoleQueryInput) GetName() string { return v.Name }
+
+// __ReleaseIpAddressInput is used internally by genqlient
+type __ReleaseIpAddressInput struct {
+	AddressId string `json:"addressId"`
+}
+
+// GetAddressId returns __ReleaseIpAddressInput.AddressId, and is useful for accessing the field via an interface.
+func (v *__ReleaseIpAddressInput) GetAddressId() string { return v.AddressId }
+
+// __ReleaseQueryInput is used internally by genqlient
+type __ReleaseQueryInput struct {
+	Name    string `json:"name"`
+	Version int    `json:"version"`
+}
+
+// GetName returns __ReleaseQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__ReleaseQueryInput) GetName() string { return v.Name }
+
+// GetVersion returns __ReleaseQueryInput.Version, and is useful for accessing the field via an interface.
+func (v *__ReleaseQueryInput) GetVersion() int { return v.Version }
+
+// __ReleasesQueryInput is used internally by genqlient
+type __ReleasesQueryInput struct {
+	Name  string `json:"name"`
+	First int    `json:"first"`
+}
+
+// GetName returns __ReleasesQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__ReleasesQueryInput) GetName() string { return v.Name }
+
+// GetFirst returns __ReleasesQueryInput.First, and is useful for accessing the field via an interface.
+func (v *__ReleasesQueryInput) GetFirst() int { return v.First }
+
+// __RemoveWireguardPeerInput is used internally by genqlient
+type __RemoveWireguardPeerInput struct {
+	Input RemoveWireGuardPeerInput `json:"input"`
+}
+
+// GetInput returns __RemoveWireguardPeerInput.Input, and is useful for accessing the field via an interface.
+func (v *__RemoveWireguardPeerInput) GetInput() RemoveWireGuardPeerInput { return v.Input }
+
+// __RestartAppMutationInput is used internally by genqlient
+type __RestartAppMutationInput struct {
+	AppId string `json:"appId"`
+}
+
+// GetAppId returns __RestartAppMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__RestartAppMutationInput) GetAppId() string { return v.AppId }
+
+// __ResumeAppMutationInput is used internally by genqlient
+type __ResumeAppMutationInput struct {
+	AppId string `json:"appId"`
+}
+
+// GetAppId returns __ResumeAppMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__ResumeAppMutationInput) GetAppId() string { return v.AppId }
+
+// __RevokePostgresClusterUserAccessMutationInput is used internally by genqlient
+type __RevokePostgresClusterUserAccessMutationInput struct {
+	AppName      string `json:"appName"`
+	Username     string `json:"username"`
+	DatabaseName string `json:"databaseName"`
+}
+
+// GetAppName returns __RevokePostgresClusterUserAccessMutationInput.AppName, and is useful for accessing the field via an interface.
+func (v *__RevokePostgresClusterUserAccessMutationInput) GetAppName() string { return v.AppName }
+
+// GetUsername returns __RevokePostgresClusterUserAccessMutationInput.Username, and is useful for accessing the field via an interface.
+func (v *__RevokePostgresClusterUserAccessMutationInput) GetUsername() string { return v.Username }
+
+// GetDatabaseName returns __RevokePostgresClusterUserAccessMutationInput.DatabaseName, and is useful for accessing the field via an interface.
+func (v *__RevokePostgresClusterUserAccessMutationInput) GetDatabaseName() string {
+	return v.DatabaseName
+}
+
+// __ScaleAppMutationInput is used internally by genqlient
+type __ScaleAppMutationInput struct {
+	AppId   string             `json:"appId"`
+	Regions []ScaleRegionInput `json:"regions"`
+}
+
+// GetAppId returns __ScaleAppMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__ScaleAppMutationInput) GetAppId() string { return v.AppId }
+
+// GetRegions returns __ScaleAppMutationInput.Regions, and is useful for accessing the field via an interface.
+func (v *__ScaleAppMutationInput) GetRegions() []ScaleRegionInput { return v.Regions }
+
+// __SetPagerdutyHandlerMutationInput is used internally by genqlient
+type __SetPagerdutyHandlerMutationInput struct {
+	OrganizationId     string      `json:"organizationId"`
+	Name               string      `json:"name"`
+	PagerdutyToken     string      `json:"pagerdutyToken"`
+	PagerdutyStatusMap interface{} `json:"pagerdutyStatusMap,omitempty"`
+}
+
+// GetOrganizationId returns __SetPagerdutyHandlerMutationInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__SetPagerdutyHandlerMutationInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetName returns __SetPagerdutyHandlerMutationInput.Name, and is useful for accessing the field via an interface.
+func (v *__SetPagerdutyHandlerMutationInput) GetName() string { return v.Name }
+
+// GetPagerdutyToken returns __SetPagerdutyHandlerMutationInput.PagerdutyToken, and is useful for accessing the field via an interface.
+func (v *__SetPagerdutyHandlerMutationInput) GetPagerdutyToken() string { return v.PagerdutyToken }
+
+// GetPagerdutyStatusMap returns __SetPagerdutyHandlerMutationInput.PagerdutyStatusMap, and is useful for accessing the field via an interface.
+func (v *__SetPagerdutyHandlerMutationInput) GetPagerdutyStatusMap() interface{} {
+	return v.PagerdutyStatusMap
+}
+
+// __SetSlackHandlerMutationInput is used internally by genqlient
+type __SetSlackHandlerMutationInput struct {
+	OrganizationId  string `json:"organizationId"`
+	Name            string `json:"name"`
+	SlackWebhookUrl string `json:"slackWebhookUrl"`
+	SlackChannel    string `json:"slackChannel,omitempty"`
+	SlackUsername   string `json:"slackUsername,omitempty"`
+	SlackIconUrl    string `json:"slackIconUrl,omitempty"`
+}
+
+// GetOrganizationId returns __SetSlackHandlerMutationInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__SetSlackHandlerMutationInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetName returns __SetSlackHandlerMutationInput.Name, and is useful for accessing the field via an interface.
+func (v *__SetSlackHandlerMutationInput) GetName() string { return v.Name }
+
+// GetSlackWebhookUrl returns __SetSlackHandlerMutationInput.SlackWebhookUrl, and is useful for accessing the field via an interface.
+func (v *__SetSlackHandlerMutationInput) GetSlackWebhookUrl() string { return v.SlackWebhookUrl }
+
+// GetSlackChannel returns __SetSlackHandlerMutationInput.SlackChannel, and is useful for accessing the field via an interface.
+func (v *__SetSlackHandlerMutationInput) GetSlackChannel() string { return v.SlackChannel }
+
+// GetSlackUsername returns __SetSlackHandlerMutationInput.SlackUsername, and is useful for accessing the field via an interface.
+func (v *__SetSlackHandlerMutationInput) GetSlackUsername() string { return v.SlackUsername }
+
+// GetSlackIconUrl returns __SetSlackHandlerMutationInput.SlackIconUrl, and is useful for accessing the field via an interface.
+func (v *__SetSlackHandlerMutationInput) GetSlackIconUrl() string { return v.SlackIconUrl }
+
+// __SetVmCountMutationInput is used internally by genqlient
+type __SetVmCountMutationInput struct {
+	AppId        string `json:"appId"`
+	Group        string `json:"group"`
+	Count        int    `json:"count"`
+	MaxPerRegion int    `json:"maxPerRegion,omitempty"`
+}
+
+// GetAppId returns __SetVmCountMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__SetVmCountMutationInput) GetAppId() string { return v.AppId }
+
+// GetGroup returns __SetVmCountMutationInput.Group, and is useful for accessing the field via an interface.
+func (v *__SetVmCountMutationInput) GetGroup() string { return v.Group }
+
+// GetCount returns __SetVmCountMutationInput.Count, and is useful for accessing the field via an interface.
+func (v *__SetVmCountMutationInput) GetCount() int { return v.Count }
+
+// GetMaxPerRegion returns __SetVmCountMutationInput.MaxPerRegion, and is useful for accessing the field via an interface.
+func (v *__SetVmCountMutationInput) GetMaxPerRegion() int { return v.MaxPerRegion }
+
+// __SetVmSizeMutationInput is used internally by genqlient
+type __SetVmSizeMutationInput struct {
+	AppId    string `json:"appId"`
+	SizeName string `json:"sizeName"`
+	MemoryMb int    `json:"memoryMb,omitempty"`
+	Group    string `json:"group,omitempty"`
+}
+
+// GetAppId returns __SetVmSizeMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__SetVmSizeMutationInput) GetAppId() string { return v.AppId }
+
+// GetSizeName returns __SetVmSizeMutationInput.SizeName, and is useful for accessing the field via an interface.
+func (v *__SetVmSizeMutationInput) GetSizeName() string { return v.SizeName }
+
+// GetMemoryMb returns __SetVmSizeMutationInput.MemoryMb, and is useful for accessing the field via an interface.
+func (v *__SetVmSizeMutationInput) GetMemoryMb() int { return v.MemoryMb }
+
+// GetGroup returns __SetVmSizeMutationInput.Group, and is useful for accessing the field via an interface.
+func (v *__SetVmSizeMutationInput) GetGroup() string { return v.Group }
+
+// __UpdateAutoScaleConfigMutationInput is used internally by genqlient
+type __UpdateAutoScaleConfigMutationInput struct {
+	Id           string                       `json:"id"`
+	Regions      []AutoscaleRegionConfigInput `json:"regions"`
+	ResetRegions bool                         `json:"resetRegions"`
+}
+
+// GetId returns __UpdateAutoScaleConfigMutationInput.Id, and is useful for accessing the field via an interface.
+func (v *__UpdateAutoScaleConfigMutationInput) GetId() string { return v.Id }
+
+// GetRegions returns __UpdateAutoScaleConfigMutationInput.Regions, and is useful for accessing the field via an interface.
+func (v *__UpdateAutoScaleConfigMutationInput) GetRegions() []AutoscaleRegionConfigInput {
+	return v.Regions
+}
+
+// GetResetRegions returns __UpdateAutoScaleConfigMutationInput.ResetRegions, and is useful for accessing the field via an interface.
+func (v *__UpdateAutoScaleConfigMutationInput) GetResetRegions() bool { return v.ResetRegions }
+
+// __UpdateAutoscaleSettingsMutationInput is used internally by genqlient
+type __UpdateAutoscaleSettingsMutationInput struct {
+	AppId          string                       `json:"appId"`
+	Enabled        *bool                        `json:"enabled,omitempty"`
+	BalanceRegions *bool                        `json:"balanceRegions,omitempty"`
+	MinCount       *int                         `json:"minCount,omitempty"`
+	MaxCount       *int                         `json:"maxCount,omitempty"`
+	Regions        []AutoscaleRegionConfigInput `json:"regions,omitempty"`
+}
+
+// GetAppId returns __UpdateAutoscaleSettingsMutationInput.AppId, and is useful for accessing the field via an interface.
+func (v *__UpdateAutoscaleSettingsMutationInput) GetAppId() string { return v.AppId }
+
+// GetEnabled returns __UpdateAutoscaleSettingsMutationInput.Enabled, and is useful for accessing the field via an interface.
+func (v *__UpdateAutoscaleSettingsMutationInput) GetEnabled() *bool { return v.Enabled }
+
+// GetBalanceRegions returns __UpdateAutoscaleSettingsMutationInput.BalanceRegions, and is useful for accessing the field via an interface.
+func (v *__UpdateAutoscaleSettingsMutationInput) GetBalanceRegions() *bool { return v.BalanceRegions }
+
+// GetMinCount returns __UpdateAutoscaleSettingsMutationInput.MinCount, and is useful for accessing the field via an interface.
+func (v *__UpdateAutoscaleSettingsMutationInput) GetMinCount() *int { return v.MinCount }
+
+// GetMaxCount returns __UpdateAutoscaleSettingsMutationInput.MaxCount, and is useful for accessing the field via an interface.
+func (v *__UpdateAutoscaleSettingsMutationInput) GetMaxCount() *int { return v.MaxCount }
+
+// GetRegions returns __UpdateAutoscaleSettingsMutationInput.Regions, and is useful for accessing the field via an interface.
+func (v *__UpdateAutoscaleSettingsMutationInput) GetRegions() []AutoscaleRegionConfigInput {
+	return v.Regions
+}
+
+// __UpdateOrganizationMembershipInput is used internally by genqlient
+type __UpdateOrganizationMembershipInput struct {
+	OrganizationId string                 `json:"organizationId"`
+	UserId         string                 `json:"userId"`
+	Role           OrganizationMemberRole `json:"role"`
+}
+
+// GetOrganizationId returns __UpdateOrganizationMembershipInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__UpdateOrganizationMembershipInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetUserId returns __UpdateOrganizationMembershipInput.UserId, and is useful for accessing the field via an interface.
+func (v *__UpdateOrganizationMembershipInput) GetUserId() string { return v.UserId }
+
+// GetRole returns __UpdateOrganizationMembershipInput.Role, and is useful for accessing the field via an interface.
+func (v *__UpdateOrganizationMembershipInput) GetRole() OrganizationMemberRole { return v.Role }
+
+// __ValidateWireGuardPeersInput is used internally by genqlient
+type __ValidateWireGuardPeersInput struct {
+	PeerIps []string `json:"peerIps"`
+}
+
+// GetPeerIps returns __ValidateWireGuardPeersInput.PeerIps, and is useful for accessing the field via an interface.
+func (v *__ValidateWireGuardPeersInput) GetPeerIps() []string { return v.PeerIps }
+
+// __VolumeQueryInput is used internally by genqlient
+type __VolumeQueryInput struct {
+	App      string `json:"app"`
+	Internal string `json:"internal"`
+}
+
+// GetApp returns __VolumeQueryInput.App, and is useful for accessing the field via an interface.
+func (v *__VolumeQueryInput) GetApp() string { return v.App }
+
+// GetInternal returns __VolumeQueryInput.Internal, and is useful for accessing the field via an interface.
+func (v *__VolumeQueryInput) GetInternal() string { return v.Internal }
+
+// __WireguardPeerQueryInput is used internally by genqlient
+type __WireguardPeerQueryInput struct {
+	Org  string `json:"org"`
+	Name string `json:"name"`
+}
+
+// GetOrg returns __WireguardPeerQueryInput.Org, and is useful for accessing the field via an interface.
+func (v *__WireguardPeerQueryInput) GetOrg() string { return v.Org }
+
+// GetName returns __WireguardPeerQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__WireguardPeerQueryInput) GetName() string { return v.Name }
+
+// __WireguardPeersQueryInput is used internally by genqlient
+type __WireguardPeersQueryInput struct {
+	Org string `json:"org"`
+}
+
+// GetOrg returns __WireguardPeersQueryInput.Org, and is useful for accessing the field via an interface.
+func (v *__WireguardPeersQueryInput) GetOrg() string { return v.Org }
+
+func AddCertificate(
+	ctx context.Context,
+	client graphql.Client,
+	app string,
+	hostname string,
+) (*AddCertificateResponse, error) {
+	__input := __AddCertificateInput{
+		App:      app,
+		Hostname: hostname,
+	}
+	var err error
+
+	var retval AddCertificateResponse
+	err = client.MakeRequest(
+		ctx,
+		"AddCertificate",
+		`
+mutation AddCertificate ($app: ID!, $hostname: String!) {
+	addCertificate(appId: $app, hostname: $hostname) {
+		certificate {
+			id
+			dnsValidationInstructions
+			dnsValidationHostname
+			dnsValidationTarget
+			hostname
+			check
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func AddWireguardPeer(
+	ctx context.Context,
+	client graphql.Client,
+	input AddWireGuardPeerInput,
+) (*AddWireguardPeerResponse, error) {
+	__input := __AddWireguardPeerInput{
+		Input: input,
+	}
+	var err error
+
+	var retval AddWireguardPeerResponse
+	err = client.MakeRequest(
+		ctx,
+		"AddWireguardPeer",
+		`
+mutation AddWireguardPeer ($input: AddWireGuardPeerInput!) {
+	addWireGuardPeer(input: $input) {
+		network
+		endpointip
+		peerip
+		pubkey
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func AllocateIpAddress(
+	ctx context.Context,
+	client graphql.Client,
+	app string,
+	region string,
+	addrType IPAddressType,
+) (*AllocateIpAddressResponse, error) {
+	__input := __AllocateIpAddressInput{
+		App:      app,
+		Region:   region,
+		AddrType: addrType,
+	}
+	var err error
+
+	var retval AllocateIpAddressResponse
+	err = client.MakeRequest(
+		ctx,
+		"AllocateIpAddress",
+		`
+mutation AllocateIpAddress ($app: ID!, $region: String, $addrType: IPAddressType) {
+	allocateIpAddress(input: {appId:$app,region:$region,type:$addrType}) {
+		ipAddress {
+			id
+			type
+			address
+			region
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func AppAutoscalingQuery(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*AppAutoscalingQueryResponse, error) {
+	__input := __AppAutoscalingQueryInput{
+		Name: name,
+	}
+	var err error
+
+	var retval AppAutoscalingQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"AppAutoscalingQuery",
+		`
+query AppAutoscalingQuery ($name: String) {
+	app(name: $name) {
+		name
+		autoscaling {
+			enabled
+			balanceRegions
+			minCount
+			maxCount
+			strategy
+			regions {
+				code
+				minCount
+				weight
+			}
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func AppConfigQuery(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*AppConfigQueryResponse, error) {
+	__input := __AppConfigQueryInput{
+		Name: name,
+	}
+	var err error
+
+	var retval AppConfigQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"AppConfigQuery",
+		`
+query AppConfigQuery ($name: String) {
+	app(name: $name) {
+		config {
+			definition
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func AppRegionsQuery(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*AppRegionsQueryResponse, error) {
+	__input := __AppRegionsQueryInput{
+		Name: name,
+	}
+	var err error
+
+	var retval AppRegionsQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"AppRegionsQuery",
+		`
+query AppRegionsQuery ($name: String) {
+	app(name: $name) {
+		name
+		regions {
+			code
+			processGroup
+		}
+		backupRegions {
+			code
+			processGroup
+		}
+		processGroups {
+			name
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func AppScaleQuery(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*AppScaleQueryResponse, error) {
+	__input := __AppScaleQueryInput{
+		Name: name,
+	}
+	var err error
+
+	var retval AppScaleQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"AppScaleQuery",
+		`
+query AppScaleQuery ($name: String) {
+	app(name: $name) {
+		name
+		vmSize {
+			name
+			memoryMb
+		}
+		taskGroupCounts {
+			name
+			count
+		}
+		processGroups {
+			name
+			maxPerRegion
+			vmSize {
+				name
+				memoryMb
+			}
+		}
+		currentPlacement {
+			region
+			count
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func AttachPostgresClusterMutation(
+	ctx context.Context,
+	client graphql.Client,
+	postgresClusterAppId string,
+	appId string,
+	databaseName string,
+	databaseUser string,
+	variableName string,
+) (*AttachPostgresClusterMutationResponse, error) {
+	__input := __AttachPostgresClusterMutationInput{
+		PostgresClusterAppId: postgresClusterAppId,
+		AppId:                appId,
+		DatabaseName:         databaseName,
+		DatabaseUser:         databaseUser,
+		VariableName:         variableName,
+	}
+	var err error
+
+	var retval AttachPostgresClusterMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"AttachPostgresClusterMutation",
+		`
+mutation AttachPostgresClusterMutation ($postgresClusterAppId: ID!, $appId: ID!, $databaseName: String, $databaseUser: String, $variableName: String) {
+	attachPostgresCluster(input: {postgresClusterAppId:$postgresClusterAppId,appId:$appId,databaseName:$databaseName,databaseUser:$databaseUser,variableName:$variableName}) {
+		connectionString
+		environmentVariableName
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CheckJobQuery(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*CheckJobQueryResponse, error) {
+	__input := __CheckJobQueryInput{
+		Id: id,
+	}
+	var err error
+
+	var retval CheckJobQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"CheckJobQuery",
+		`
+query CheckJobQuery ($id: ID!) {
+	node(id: $id) {
+		__typename
+		... on CheckJob {
+			... CheckJobFragment
+		}
+	}
+}
+fragment CheckJobFragment on CheckJob {
+	id
+	url
+	schedule
+	httpOptions {
+		verb
+		headers
+	}
+	locations {
+		nodes {
+			name
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CheckJobRunsQuery(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	last int,
+) (*CheckJobRunsQueryResponse, error) {
+	__input := __CheckJobRunsQueryInput{
+		Id:   id,
+		Last: last,
+	}
+	var err error
+
+	var retval CheckJobRunsQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"CheckJobRunsQuery",
+		`
+query CheckJobRunsQuery ($id: ID!, $last: Int) {
+	node(id: $id) {
+		__typename
+		... on CheckJob {
+			runs(last: $last) {
+				nodes {
+					id
+					state
+					tests
+					url
+					createdAt
+					completedAt
+					httpResponses {
+						nodes {
+							statusCode
+							resolvedIp
+							location {
+								name
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CheckLocationsQuery(
+	ctx context.Context,
+	client graphql.Client,
+) (*CheckLocationsQueryResponse, error) {
+	var err error
+
+	var retval CheckLocationsQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"CheckLocationsQuery",
+		`
+query CheckLocationsQuery {
+	checkLocations {
+		name
+		title
+		locality
+		state
+		country
+	}
+}
+`,
+		&retval,
+		nil,
+	)
+	return &retval, err
+}
+
+// A nil list is sent as null, which leaves that list alone, while an empty one clears it
+func ConfigureRegionsMutation(
+	ctx context.Context,
+	client graphql.Client,
+	appId string,
+	allowRegions []string,
+	denyRegions []string,
+	backupRegions []string,
+	group string,
+) (*ConfigureRegionsMutationResponse, error) {
+	__input := __ConfigureRegionsMutationInput{
+		AppId:         appId,
+		AllowRegions:  allowRegions,
+		DenyRegions:   denyRegions,
+		BackupRegions: backupRegions,
+		Group:         group,
+	}
+	var err error
+
+	var retval ConfigureRegionsMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"ConfigureRegionsMutation",
+		`
+mutation ConfigureRegionsMutation ($appId: ID!, $allowRegions: [String!], $denyRegions: [String!], $backupRegions: [String!], $group: String) {
+	configureRegions(input: {appId:$appId,allowRegions:$allowRegions,denyRegions:$denyRegions,backupRegions:$backupRegions,group:$group}) {
+		regions {
+			code
+		}
+		backupRegions {
+			code
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreateAppMutation(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	organizationId string,
+	preferredRegion string,
+	network string,
+) (*CreateAppMutationResponse, error) {
+	__input := __CreateAppMutationInput{
+		Name:            name,
+		OrganizationId:  organizationId,
+		PreferredRegion: preferredRegion,
+		Network:         network,
+	}
+	var err error
+
+	var retval CreateAppMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreateAppMutation",
+		`
+mutation CreateAppMutation ($name: String, $organizationId: ID!, $preferredRegion: String, $network: String) {
+	createApp(input: {name:$name,organizationId:$organizationId,preferredRegion:$preferredRegion,network:$network}) {
+		app {
+			name
+			status
+			autoscaling {
+				preferredRegion
+			}
+			organization {
+				id
+				slug
+			}
+			network
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreateAppMutationWithAutoscaleConfig(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	id string,
+	organizationId string,
+	preferredRegion string,
+	network string,
+	regions []AutoscaleRegionConfigInput,
+) (*CreateAppMutationWithAutoscaleConfigResponse, error) {
+	__input := __CreateAppMutationWithAutoscaleConfigInput{
+		Name:            name,
+		Id:              id,
+		OrganizationId:  organizationId,
+		PreferredRegion: preferredRegion,
+		Network:         network,
+		Regions:         regions,
+	}
+	var err error
+
+	var retval CreateAppMutationWithAutoscaleConfigResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreateAppMutationWithAutoscaleConfig",
+		`
+mutation CreateAppMutationWithAutoscaleConfig ($name: String, $id: ID!, $organizationId: ID!, $preferredRegion: String, $network: String, $regions: [AutoscaleRegionConfigInput!]) {
+	createApp(input: {name:$name,organizationId:$organizationId,preferredRegion:$preferredRegion,network:$network}) {
+		app {
+			name
+			status
+			autoscaling {
+				preferredRegion
+			}
+			organization {
+				id
+				slug
+			}
+			network
+		}
+	}
+	updateAutoscaleConfig(input: {regions:$regions,appId:$id}) {
+		app {
+			status
+			autoscaling {
+				regions {
+					code
+				}
+			}
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreateCheckJobMutation(
+	ctx context.Context,
+	client graphql.Client,
+	organizationId string,
+	url string,
+	locations []string,
+	verb CheckHTTPVerb,
+	headers []string,
+) (*CreateCheckJobMutationResponse, error) {
+	__input := __CreateCheckJobMutationInput{
+		OrganizationId: organizationId,
+		Url:            url,
+		Locations:      locations,
+		Verb:           verb,
+		Headers:        headers,
+	}
+	var err error
+
+	var retval CreateCheckJobMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreateCheckJobMutation",
+		`
+mutation CreateCheckJobMutation ($organizationId: ID!, $url: String!, $locations: [String!]!, $verb: CheckHTTPVerb!, $headers: [String!]) {
+	createCheckJob(input: {organizationId:$organizationId,url:$url,locations:$locations,httpOptions:{verb:$verb,headers:$headers}}) {
+		checkJob {
+			... CheckJobFragment
+		}
+	}
+}
+fragment CheckJobFragment on CheckJob {
+	id
+	url
+	schedule
+	httpOptions {
+		verb
+		headers
+	}
+	locations {
+		nodes {
+			name
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreateCheckJobRunMutation(
+	ctx context.Context,
+	client graphql.Client,
+	checkJobId string,
+) (*CreateCheckJobRunMutationResponse, error) {
+	__input := __CreateCheckJobRunMutationInput{
+		CheckJobId: checkJobId,
+	}
+	var err error
+
+	var retval CreateCheckJobRunMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreateCheckJobRunMutation",
+		`
+mutation CreateCheckJobRunMutation ($checkJobId: ID!) {
+	createCheckJobRun(input: {checkJobId:$checkJobId}) {
+		checkJobRun {
+			id
+			state
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreateDelegatedWireGuardToken(
+	ctx context.Context,
+	client graphql.Client,
+	organizationId string,
+	name string,
+) (*CreateDelegatedWireGuardTokenResponse, error) {
+	__input := __CreateDelegatedWireGuardTokenInput{
+		OrganizationId: organizationId,
+		Name:           name,
+	}
+	var err error
+
+	var retval CreateDelegatedWireGuardTokenResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreateDelegatedWireGuardToken",
+		`
+mutation CreateDelegatedWireGuardToken ($organizationId: ID!, $name: String) {
+	createDelegatedWireGuardToken(input: {organizationId:$organizationId,name:$name}) {
+		token
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreateDoctorReport(
+	ctx context.Context,
+	client graphql.Client,
+	data interface{},
+) (*CreateDoctorReportResponse, error) {
+	__input := __CreateDoctorReportInput{
+		Data: data,
+	}
+	var err error
+
+	var retval CreateDoctorReportResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreateDoctorReport",
+		`
+mutation CreateDoctorReport ($data: JSON!) {
+	createDoctorReport(input: {data:$data}) {
+		reportId
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreateOrganization(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*CreateOrganizationResponse, error) {
+	__input := __CreateOrganizationInput{
+		Name: name,
+	}
+	var err error
+
+	var retval CreateOrganizationResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreateOrganization",
+		`
+mutation CreateOrganization ($name: String!) {
+	createOrganization(input: {name:$name}) {
+		organization {
+			id
+			slug
+			name
+			type
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreateOrganizationInvitation(
+	ctx context.Context,
+	client graphql.Client,
+	organizationId string,
+	email string,
+) (*CreateOrganizationInvitationResponse, error) {
+	__input := __CreateOrganizationInvitationInput{
+		OrganizationId: organizationId,
+		Email:          email,
+	}
+	var err error
+
+	var retval CreateOrganizationInvitationResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreateOrganizationInvitation",
+		`
+mutation CreateOrganizationInvitation ($organizationId: ID!, $email: String!) {
+	createOrganizationInvitation(input: {organizationId:$organizationId,email:$email}) {
+		invitation {
+			id
+			email
+			redeemed
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreatePostgresCluster(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	orgid string,
+	region string,
+	password string,
+	vmsize string,
+	volumesize int,
+	count int,
+	imageref string,
+) (*CreatePostgresClusterResponse, error) {
+	__input := __CreatePostgresClusterInput{
+		Name:       name,
+		Orgid:      orgid,
+		Region:     region,
+		Password:   password,
+		Vmsize:     vmsize,
+		Volumesize: volumesize,
+		Count:      count,
+		Imageref:   imageref,
+	}
+	var err error
+
+	var retval CreatePostgresClusterResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreatePostgresCluster",
+		`
+mutation CreatePostgresCluster ($name: String, $orgid: ID!, $region: String, $password: String, $vmsize: String, $volumesize: Int, $count: Int, $imageref: String) {
+	createPostgresCluster(input: {name:$name,organizationId:$orgid,region:$region,password:$password,vmSize:$vmsize,volumeSizeGb:$volumesize,count:$count,imageRef:$imageref}) {
+		app {
+			name
+		}
+		username
+		password
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreatePostgresClusterDatabaseMutation(
+	ctx context.Context,
+	client graphql.Client,
+	appName string,
+	databaseName string,
+) (*CreatePostgresClusterDatabaseMutationResponse, error) {
+	__input := __CreatePostgresClusterDatabaseMutationInput{
+		AppName:      appName,
+		DatabaseName: databaseName,
+	}
+	var err error
+
+	var retval CreatePostgresClusterDatabaseMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreatePostgresClusterDatabaseMutation",
+		`
+mutation CreatePostgresClusterDatabaseMutation ($appName: String!, $databaseName: String!) {
+	createPostgresClusterDatabase(input: {appName:$appName,databaseName:$databaseName}) {
+		database {
+			name
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreatePostgresClusterUserMutation(
+	ctx context.Context,
+	client graphql.Client,
+	appName string,
+	username string,
+	password string,
+	superuser bool,
+) (*CreatePostgresClusterUserMutationResponse, error) {
+	__input := __CreatePostgresClusterUserMutationInput{
+		AppName:   appName,
+		Username:  username,
+		Password:  password,
+		Superuser: superuser,
+	}
+	var err error
+
+	var retval CreatePostgresClusterUserMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreatePostgresClusterUserMutation",
+		`
+mutation CreatePostgresClusterUserMutation ($appName: String!, $username: String!, $password: String!, $superuser: Boolean) {
+	createPostgresClusterUser(input: {appName:$appName,username:$username,password:$password,superuser:$superuser}) {
+		user {
+			username
+			isSuperuser
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CreateVolume(
+	ctx context.Context,
+	client graphql.Client,
+	app string,
+	name string,
+	region string,
+	sizeGb int,
+) (*CreateVolumeResponse, error) {
+	__input := __CreateVolumeInput{
+		App:    app,
+		Name:   name,
+		Region: region,
+		SizeGb: sizeGb,
+	}
+	var err error
+
+	var retval CreateVolumeResponse
+	err = client.MakeRequest(
+		ctx,
+		"CreateVolume",
+		`
+mutation CreateVolume ($app: ID!, $name: String!, $region: String!, $sizeGb: Int!) {
+	createVolume(input: {appId:$app,name:$name,region:$region,sizeGb:$sizeGb}) {
+		volume {
+			name
+			region
+			id
+			internalId
+			region
+			sizeGb
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func CurrentReleaseQuery(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*CurrentReleaseQueryResponse, error) {
+	__input := __CurrentReleaseQueryInput{
+		Name: name,
+	}
+	var err error
+
+	var retval CurrentReleaseQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"CurrentReleaseQuery",
+		`
+query CurrentReleaseQuery ($name: String) {
+	app(name: $name) {
+		currentRelease {
+			... ReleaseFragment
+			image {
+				registry
+				repository
+				tag
+				digest
+			}
+		}
+	}
+}
+fragment ReleaseFragment on Release {
+	id
+	version
+	status
+	reason
+	description
+	stable
+	imageRef
+	user {
+		email
+	}
+	createdAt
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DelegatedWireGuardTokensQuery(
+	ctx context.Context,
+	client graphql.Client,
+	org string,
+) (*DelegatedWireGuardTokensQueryResponse, error) {
+	__input := __DelegatedWireGuardTokensQueryInput{
+		Org: org,
+	}
+	var err error
+
+	var retval DelegatedWireGuardTokensQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"DelegatedWireGuardTokensQuery",
+		`
+query DelegatedWireGuardTokensQuery ($org: ID!) {
+	organization(id: $org) {
+		delegatedWireGuardTokens {
+			nodes {
+				id
+				name
+			}
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeleteAppMutation(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*DeleteAppMutationResponse, error) {
+	__input := __DeleteAppMutationInput{
+		Name: name,
+	}
+	var err error
+
+	var retval DeleteAppMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeleteAppMutation",
+		`
+mutation DeleteAppMutation ($name: ID!) {
+	deleteApp(appId: $name) {
+		organization {
+			name
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeleteCertificate(
+	ctx context.Context,
+	client graphql.Client,
+	app string,
+	hostname string,
+) (*DeleteCertificateResponse, error) {
+	__input := __DeleteCertificateInput{
+		App:      app,
+		Hostname: hostname,
+	}
+	var err error
+
+	var retval DeleteCertificateResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeleteCertificate",
+		`
+mutation DeleteCertificate ($app: ID!, $hostname: String!) {
+	deleteCertificate(appId: $app, hostname: $hostname) {
+		app {
+			name
+		}
+		certificate {
+			hostname
+			id
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeleteDelegatedWireGuardToken(
+	ctx context.Context,
+	client graphql.Client,
+	organizationId string,
+	name string,
+) (*DeleteDelegatedWireGuardTokenResponse, error) {
+	__input := __DeleteDelegatedWireGuardTokenInput{
+		OrganizationId: organizationId,
+		Name:           name,
+	}
+	var err error
+
+	var retval DeleteDelegatedWireGuardTokenResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeleteDelegatedWireGuardToken",
+		`
+mutation DeleteDelegatedWireGuardToken ($organizationId: ID!, $name: String) {
+	deleteDelegatedWireGuardToken(input: {organizationId:$organizationId,name:$name}) {
+		token
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeleteHealthCheckHandlerMutation(
+	ctx context.Context,
+	client graphql.Client,
+	organizationId string,
+	name string,
+) (*DeleteHealthCheckHandlerMutationResponse, error) {
+	__input := __DeleteHealthCheckHandlerMutationInput{
+		OrganizationId: organizationId,
+		Name:           name,
+	}
+	var err error
+
+	var retval DeleteHealthCheckHandlerMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeleteHealthCheckHandlerMutation",
+		`
+mutation DeleteHealthCheckHandlerMutation ($organizationId: ID!, $name: String!) {
+	deleteHealthCheckHandler(input: {organizationId:$organizationId,name:$name}) {
+		clientMutationId
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeleteOrganization(
+	ctx context.Context,
+	client graphql.Client,
+	organizationId string,
+) (*DeleteOrganizationResponse, error) {
+	__input := __DeleteOrganizationInput{
+		OrganizationId: organizationId,
+	}
+	var err error
+
+	var retval DeleteOrganizationResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeleteOrganization",
+		`
+mutation DeleteOrganization ($organizationId: ID!) {
+	deleteOrganization(input: {organizationId:$organizationId}) {
+		deletedOrganizationId
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeleteOrganizationInvitation(
+	ctx context.Context,
+	client graphql.Client,
+	invitationId string,
+) (*DeleteOrganizationInvitationResponse, error) {
+	__input := __DeleteOrganizationInvitationInput{
+		InvitationId: invitationId,
+	}
+	var err error
+
+	var retval DeleteOrganizationInvitationResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeleteOrganizationInvitation",
+		`
+mutation DeleteOrganizationInvitation ($invitationId: ID!) {
+	deleteOrganizationInvitation(input: {invitationId:$invitationId}) {
+		organization {
+			id
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeleteOrganizationMembership(
+	ctx context.Context,
+	client graphql.Client,
+	organizationId string,
+	userId string,
+) (*DeleteOrganizationMembershipResponse, error) {
+	__input := __DeleteOrganizationMembershipInput{
+		OrganizationId: organizationId,
+		UserId:         userId,
+	}
+	var err error
+
+	var retval DeleteOrganizationMembershipResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeleteOrganizationMembership",
+		`
+mutation DeleteOrganizationMembership ($organizationId: ID!, $userId: ID!) {
+	deleteOrganizationMembership(input: {organizationId:$organizationId,userId:$userId}) {
+		user {
+			id
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeleteVolume(
+	ctx context.Context,
+	client graphql.Client,
+	volume string,
+) (*DeleteVolumeResponse, error) {
+	__input := __DeleteVolumeInput{
+		Volume: volume,
+	}
+	var err error
+
+	var retval DeleteVolumeResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeleteVolume",
+		`
+mutation DeleteVolume ($volume: ID!) {
+	deleteVolume(input: {volumeId:$volume}) {
+		clientMutationId
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeployImageMutation(
+	ctx context.Context,
+	client graphql.Client,
+	appId string,
+	image string,
+	definition interface{},
+	strategy DeploymentStrategy,
+) (*DeployImageMutationResponse, error) {
+	__input := __DeployImageMutationInput{
+		AppId:      appId,
+		Image:      image,
+		Definition: definition,
+		Strategy:   strategy,
+	}
+	var err error
+
+	var retval DeployImageMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeployImageMutation",
+		`
+mutation DeployImageMutation ($appId: ID!, $image: String!, $definition: JSON, $strategy: DeploymentStrategy) {
+	deployImage(input: {appId:$appId,image:$image,definition:$definition,strategy:$strategy}) {
+		release {
+			id
+			version
+			evaluationId
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DeploymentStatusQuery(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	evaluationId string,
+) (*DeploymentStatusQueryResponse, error) {
+	__input := __DeploymentStatusQueryInput{
+		Name:         name,
+		EvaluationId: evaluationId,
+	}
+	var err error
+
+	var retval DeploymentStatusQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"DeploymentStatusQuery",
+		`
+query DeploymentStatusQuery ($name: String, $evaluationId: String) {
+	app(name: $name) {
+		deploymentStatus(evaluationId: $evaluationId) {
+			id
+			status
+			description
+			inProgress
+			successful
+			desiredCount
+			healthyCount
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func DetachPostgresClusterMutation(
+	ctx context.Context,
+	client graphql.Client,
+	postgresClusterAppId string,
+	appId string,
+	postgresClusterAttachmentId string,
+) (*DetachPostgresClusterMutationResponse, error) {
+	__input := __DetachPostgresClusterMutationInput{
+		PostgresClusterAppId:        postgresClusterAppId,
+		AppId:                       appId,
+		PostgresClusterAttachmentId: postgresClusterAttachmentId,
+	}
+	var err error
+
+	var retval DetachPostgresClusterMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"DetachPostgresClusterMutation",
+		`
+mutation DetachPostgresClusterMutation ($postgresClusterAppId: ID!, $appId: ID!, $postgresClusterAttachmentId: ID) {
+	detachPostgresCluster(input: {postgresClusterAppId:$postgresClusterAppId,appId:$appId,postgresClusterAttachmentId:$postgresClusterAttachmentId}) {
+		app {
+			name
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func GetCertificate(
+	ctx context.Context,
+	client graphql.Client,
+	app string,
+	hostname string,
+) (*GetCertificateResponse, error) {
+	__input := __GetCertificateInput{
+		App:      app,
+		Hostname: hostname,
+	}
+	var err error
+
+	var retval GetCertificateResponse
+	err = client.MakeRequest(
+		ctx,
+		"GetCertificate",
+		`
+query GetCertificate ($app: String!, $hostname: String!) {
+	app(name: $app) {
+		certificate(hostname: $hostname) {
+			id
+			dnsValidationInstructions
+			dnsValidationHostname
+			dnsValidationTarget
+			hostname
+			check
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func GetFullApp(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*GetFullAppResponse, error) {
+	__input := __GetFullAppInput{
+		Name: name,
+	}
+	var err error
+
+	var retval GetFullAppResponse
+	err = client.MakeRequest(
+		ctx,
+		"GetFullApp",
+		`
+query GetFullApp ($name: String) {
+	app(name: $name) {
+		name
+		network
+		organization {
+			id
+			slug
+		}
+		autoscaling {
+			preferredRegion
+			regions {
+				code
+			}
+		}
+		appUrl
+		hostname
+		id
+		status
+		deployed
+		currentRelease {
+			id
+		}
+		config {
+			definition
+		}
+		healthChecks {
+			nodes {
+				name
+				status
+			}
+		}
+		ipAddresses {
+			nodes {
+				address
+				id
+			}
+		}
+		role {
+			__typename
+			name
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func GetFullOrganization(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	slug string,
+) (*GetFullOrganizationResponse, error) {
+	__input := __GetFullOrganizationInput{
+		Id:   id,
+		Slug: slug,
+	}
+	var err error
+
+	var retval GetFullOrganizationResponse
+	err = client.MakeRequest(
+		ctx,
+		"GetFullOrganization",
+		`
+query GetFullOrganization ($id: ID, $slug: String) {
+	organization(id: $id, slug: $slug) {
+		id
+		slug
+		name
+		type
+		billingStatus
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func GrantPostgresClusterUserAccessMutation(
+	ctx context.Context,
+	client graphql.Client,
+	appName string,
+	username string,
+	databaseName string,
+) (*GrantPostgresClusterUserAccessMutationResponse, error) {
+	__input := __GrantPostgresClusterUserAccessMutationInput{
+		AppName:      appName,
+		Username:     username,
+		DatabaseName: databaseName,
+	}
+	var err error
+
+	var retval GrantPostgresClusterUserAccessMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"GrantPostgresClusterUserAccessMutation",
+		`
+mutation GrantPostgresClusterUserAccessMutation ($appName: String!, $username: String!, $databaseName: String!) {
+	grantPostgresClusterUserAccess(input: {appName:$appName,username:$username,databaseName:$databaseName}) {
+		user {
+			username
+			databases
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func HealthCheckHandlersQuery(
+	ctx context.Context,
+	client graphql.Client,
+	org string,
+) (*HealthCheckHandlersQueryResponse, error) {
+	__input := __HealthCheckHandlersQueryInput{
+		Org: org,
+	}
+	var err error
+
+	var retval HealthCheckHandlersQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"HealthCheckHandlersQuery",
+		`
+query HealthCheckHandlersQuery ($org: ID!) {
+	organization(id: $org) {
+		healthCheckHandlers {
+			nodes {
+				name
+				type
+			}
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func IpAddressQuery(
+	ctx context.Context,
+	client graphql.Client,
+	app string,
+	addr string,
+) (*IpAddressQueryResponse, error) {
+	__input := __IpAddressQueryInput{
+		App:  app,
+		Addr: addr,
+	}
+	var err error
+
+	var retval IpAddressQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"IpAddressQuery",
+		`
+query IpAddressQuery ($app: String, $addr: String!) {
+	app(name: $app) {
+		ipAddress(address: $addr) {
+			id
+			type
+			address
+			region
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func MoveAppMutation(
+	ctx context.Context,
+	client graphql.Client,
+	appId string,
+	organizationId string,
+) (*MoveAppMutationResponse, error) {
+	__input := __MoveAppMutationInput{
+		AppId:          appId,
+		OrganizationId: organizationId,
+	}
+	var err error
+
+	var retval MoveAppMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"MoveAppMutation",
+		`
+mutation MoveAppMutation ($appId: ID!, $organizationId: ID!) {
+	moveApp(input: {appId:$appId,organizationId:$organizationId}) {
+		app {
+			name
+			organization {
+				id
+				slug
+			}
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func OrgInvitationsQuery(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	after string,
+) (*OrgInvitationsQueryResponse, error) {
+	__input := __OrgInvitationsQueryInput{
+		Id:    id,
+		After: after,
+	}
+	var err error
+
+	var retval OrgInvitationsQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"OrgInvitationsQuery",
+		`
+query OrgInvitationsQuery ($id: ID!, $after: String) {
+	organization(id: $id) {
+		invitations(first: 50, after: $after) {
+			nodes {
+				... OrgInvitationFragment
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+fragment OrgInvitationFragment on OrganizationInvitation {
+	id
+	email
+	redeemed
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func OrgMembersQuery(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	after string,
+) (*OrgMembersQueryResponse, error) {
+	__input := __OrgMembersQueryInput{
+		Id:    id,
+		After: after,
+	}
+	var err error
+
+	var retval OrgMembersQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"OrgMembersQuery",
+		`
+query OrgMembersQuery ($id: ID!, $after: String) {
+	organization(id: $id) {
+		members(first: 50, after: $after) {
+			edges {
+				... OrgMemberFragment
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+fragment OrgMemberFragment on OrganizationMembershipsEdge {
+	role
+	joinedAt
+	node {
+		id
+		email
+		name
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func OrgQuery(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	slug string,
+) (*OrgQueryResponse, error) {
+	__input := __OrgQueryInput{
+		Id:   id,
+		Slug: slug,
+	}
+	var err error
+
+	var retval OrgQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"OrgQuery",
+		`
+query OrgQuery ($id: ID, $slug: String) {
+	organization(id: $id, slug: $slug) {
+		name
+		id
+		slug
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func OrgsQuery(
+	ctx context.Context,
+	client graphql.Client,
+	after string,
+) (*OrgsQueryResponse, error) {
+	__input := __OrgsQueryInput{
+		After: after,
+	}
+	var err error
+
+	var retval OrgsQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"OrgsQuery",
+		`
+query OrgsQuery ($after: String) {
+	organizations(first: 50, after: $after) {
+		nodes {
+			name
+			id
+			slug
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func ParseConfigQuery(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	definition interface{},
+) (*ParseConfigQueryResponse, error) {
+	__input := __ParseConfigQueryInput{
+		Name:       name,
+		Definition: definition,
+	}
+	var err error
+
+	var retval ParseConfigQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"ParseConfigQuery",
+		`
+query ParseConfigQuery ($name: String, $definition: JSON!) {
+	app(name: $name) {
+		parseConfig(definition: $definition) {
+			definition
+			valid
+			errors
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func PauseAppMutation(
+	ctx context.Context,
+	client graphql.Client,
+	appId string,
+) (*PauseAppMutationResponse, error) {
+	__input := __PauseAppMutationInput{
+		AppId: appId,
+	}
+	var err error
+
+	var retval PauseAppMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"PauseAppMutation",
+		`
+mutation PauseAppMutation ($appId: ID!) {
+	pauseApp(input: {appId:$appId}) {
+		app {
+			status
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func PostgresAttachmentAppsQuery(
+	ctx context.Context,
+	client graphql.Client,
+	postgresApp string,
+	app string,
+) (*PostgresAttachmentAppsQueryResponse, error) {
+	__input := __PostgresAttachmentAppsQueryInput{
+		PostgresApp: postgresApp,
+		App:         app,
+	}
+	var err error
+
+	var retval PostgresAttachmentAppsQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"PostgresAttachmentAppsQuery",
+		`
+query PostgresAttachmentAppsQuery ($postgresApp: String, $app: String) {
+	postgresApp: app(name: $postgresApp) {
+		id
+	}
+	app(name: $app) {
+		id
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
 }
 
-// GetInput returns __RemoveWireguardPeerInput.Input, and is useful for accessing the field via an interface.
-func (v *__RemoveWireguardPeerInput) GetInput() RemoveWireGuardPeerInput { return v.Input }
+func PostgresAttachmentsQuery(
+	ctx context.Context,
+	client graphql.Client,
+	appName string,
+	postgresAppName string,
+) (*PostgresAttachmentsQueryResponse, error) {
+	__input := __PostgresAttachmentsQueryInput{
+		AppName:         appName,
+		PostgresAppName: postgresAppName,
+	}
+	var err error
 
-// __UpdateAutoScaleConfigMutationInput is used internally by genqlient
-type __UpdateAutoScaleConfigMutationInput struct {
-	Id           string                       `json:"id"`
-	Regions      []AutoscaleRegionConfigInput `json:"regions"`
-	ResetRegions bool                         `json:"resetRegions"`
+	var retval PostgresAttachmentsQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"PostgresAttachmentsQuery",
+		`
+query PostgresAttachmentsQuery ($appName: String!, $postgresAppName: String!) {
+	postgresAttachments(appName: $appName, postgresAppName: $postgresAppName) {
+		nodes {
+			... PostgresAttachmentFragment
+		}
+	}
 }
-
-// GetId returns __UpdateAutoScaleConfigMutationInput.Id, and is useful for accessing the field via an interface.
-func (v *__UpdateAutoScaleConfigMutationInput) GetId() string { return v.Id }
-
-// GetRegions returns __UpdateAutoScaleConfigMutationInput.Regions, and is useful for accessing the field via an interface.
-func (v *__UpdateAutoScaleConfigMutationInput) GetRegions() []AutoscaleRegionConfigInput {
-	return v.Regions
+fragment PostgresAttachmentFragment on PostgresClusterAttachment {
+	id
+	databaseName
+	databaseUser
+	environmentVariableName
 }
-
-// GetResetRegions returns __UpdateAutoScaleConfigMutationInput.ResetRegions, and is useful for accessing the field via an interface.
-func (v *__UpdateAutoScaleConfigMutationInput) GetResetRegions() bool { return v.ResetRegions }
-
-// __VolumeQueryInput is used internally by genqlient
-type __VolumeQueryInput struct {
-	App      string `json:"app"`
-	Internal string `json:"internal"`
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
 }
 
-// GetApp returns __VolumeQueryInput.App, and is useful for accessing the field via an interface.
-func (v *__VolumeQueryInput) GetApp() string { return v.App }
-
-// GetInternal returns __VolumeQueryInput.Internal, and is useful for accessing the field via an interface.
-func (v *__VolumeQueryInput) GetInternal() string { return v.Internal }
-
-func AddCertificate(
+func PostgresClusterRoleQuery(
 	ctx context.Context,
 	client graphql.Client,
-	app string,
-	hostname string,
-) (*AddCertificateResponse, error) {
-	__input := __AddCertificateInput{
-		App:      app,
-		Hostname: hostname,
+	name string,
+) (*PostgresClusterRoleQueryResponse, error) {
+	__input := __PostgresClusterRoleQueryInput{
+		Name: name,
 	}
 	var err error
 
-	var retval AddCertificateResponse
+	var retval PostgresClusterRoleQueryResponse
 	err = client.MakeRequest(
 		ctx,
-		"AddCertificate",
+		"PostgresClusterRoleQuery",
 		`
-mutation AddCertificate ($app: ID!, $hostname: String!) {
-	addCertificate(appId: $app, hostname: $hostname) {
-		certificate {
-			id
-			dnsValidationInstructions
-			dnsValidationHostname
-			dnsValidationTarget
-			hostname
-			check
+query PostgresClusterRoleQuery ($name: String) {
+	app(name: $name) {
+		role {
+			__typename
+			name
+			... on PostgresClusterAppRole {
+				databases {
+					name
+					users
+				}
+				users {
+					username
+					isSuperuser
+					databases
+				}
+			}
 		}
 	}
 }
@@ -1707,27 +9530,26 @@ mutation AddCertificate ($app: ID!, $hostname: String!) {
 	return &retval, err
 }
 
-func AddWireguardPeer(
+func ReleaseIpAddress(
 	ctx context.Context,
 	client graphql.Client,
-	input AddWireGuardPeerInput,
-) (*AddWireguardPeerResponse, error) {
-	__input := __AddWireguardPeerInput{
-		Input: input,
+	addressId string,
+) (*ReleaseIpAddressResponse, error) {
+	__input := __ReleaseIpAddressInput{
+		AddressId: addressId,
 	}
 	var err error
 
-	var retval AddWireguardPeerResponse
+	var retval ReleaseIpAddressResponse
 	err = client.MakeRequest(
 		ctx,
-		"AddWireguardPeer",
+		"ReleaseIpAddress",
 		`
-mutation AddWireguardPeer ($input: AddWireGuardPeerInput!) {
-	addWireGuardPeer(input: $input) {
-		network
-		endpointip
-		peerip
-		pubkey
+mutation ReleaseIpAddress ($addressId: ID!) {
+	releaseIpAddress(input: {ipAddressId:$addressId}) {
+		app {
+			name
+		}
 	}
 }
 `,
@@ -1737,35 +9559,46 @@ mutation AddWireguardPeer ($input: AddWireGuardPeerInput!) {
 	return &retval, err
 }
 
-func AllocateIpAddress(
+func ReleaseQuery(
 	ctx context.Context,
 	client graphql.Client,
-	app string,
-	region string,
-	addrType IPAddressType,
-) (*AllocateIpAddressResponse, error) {
-	__input := __AllocateIpAddressInput{
-		App:      app,
-		Region:   region,
-		AddrType: addrType,
+	name string,
+	version int,
+) (*ReleaseQueryResponse, error) {
+	__input := __ReleaseQueryInput{
+		Name:    name,
+		Version: version,
 	}
 	var err error
 
-	var retval AllocateIpAddressResponse
+	var retval ReleaseQueryResponse
 	err = client.MakeRequest(
 		ctx,
-		"AllocateIpAddress",
+		"ReleaseQuery",
 		`
-mutation AllocateIpAddress ($app: ID!, $region: String, $addrType: IPAddressType) {
-	allocateIpAddress(input: {appId:$app,region:$region,type:$addrType}) {
-		ipAddress {
-			id
-			type
-			address
-			region
+query ReleaseQuery ($name: String, $version: Int!) {
+	app(name: $name) {
+		release(version: $version) {
+			... ReleaseFragment
+			config {
+				definition
+			}
 		}
 	}
 }
+fragment ReleaseFragment on Release {
+	id
+	version
+	status
+	reason
+	description
+	stable
+	imageRef
+	user {
+		email
+	}
+	createdAt
+}
 `,
 		&retval,
 		&__input,
@@ -1773,42 +9606,45 @@ mutation AllocateIpAddress ($app: ID!, $region: String, $addrType: IPAddressType
 	return &retval, err
 }
 
-func CreateAppMutation(
+func ReleasesQuery(
 	ctx context.Context,
 	client graphql.Client,
 	name string,
-	organizationId string,
-	preferredRegion string,
-	network string,
-) (*CreateAppMutationResponse, error) {
-	__input := __CreateAppMutationInput{
-		Name:            name,
-		OrganizationId:  organizationId,
-		PreferredRegion: preferredRegion,
-		Network:         network,
+	first int,
+) (*ReleasesQueryResponse, error) {
+	__input := __ReleasesQueryInput{
+		Name:  name,
+		First: first,
 	}
 	var err error
 
-	var retval CreateAppMutationResponse
+	var retval ReleasesQueryResponse
 	err = client.MakeRequest(
 		ctx,
-		"CreateAppMutation",
+		"ReleasesQuery",
 		`
-mutation CreateAppMutation ($name: String, $organizationId: ID!, $preferredRegion: String, $network: String) {
-	createApp(input: {name:$name,organizationId:$organizationId,preferredRegion:$preferredRegion,network:$network}) {
-		app {
-			name
-			status
-			autoscaling {
-				preferredRegion
-			}
-			organization {
-				id
+query ReleasesQuery ($name: String, $first: Int) {
+	app(name: $name) {
+		releases(first: $first) {
+			nodes {
+				... ReleaseFragment
 			}
-			network
 		}
 	}
 }
+fragment ReleaseFragment on Release {
+	id
+	version
+	status
+	reason
+	description
+	stable
+	imageRef
+	user {
+		email
+	}
+	createdAt
+}
 `,
 		&retval,
 		&__input,
@@ -1816,53 +9652,54 @@ mutation CreateAppMutation ($name: String, $organizationId: ID!, $preferredRegio
 	return &retval, err
 }
 
-func CreateAppMutationWithAutoscaleConfig(
+func RemoveWireguardPeer(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-	id string,
-	organizationId string,
-	preferredRegion string,
-	network string,
-	regions []AutoscaleRegionConfigInput,
-) (*CreateAppMutationWithAutoscaleConfigResponse, error) {
-	__input := __CreateAppMutationWithAutoscaleConfigInput{
-		Name:            name,
-		Id:              id,
-		OrganizationId:  organizationId,
-		PreferredRegion: preferredRegion,
-		Network:         network,
-		Regions:         regions,
+	input RemoveWireGuardPeerInput,
+) (*RemoveWireguardPeerResponse, error) {
+	__input := __RemoveWireguardPeerInput{
+		Input: input,
 	}
 	var err error
 
-	var retval CreateAppMutationWithAutoscaleConfigResponse
+	var retval RemoveWireguardPeerResponse
 	err = client.MakeRequest(
 		ctx,
-		"CreateAppMutationWithAutoscaleConfig",
+		"RemoveWireguardPeer",
 		`
-mutation CreateAppMutationWithAutoscaleConfig ($name: String, $id: ID!, $organizationId: ID!, $preferredRegion: String, $network: String, $regions: [AutoscaleRegionConfigInput!]) {
-	createApp(input: {name:$name,organizationId:$organizationId,preferredRegion:$preferredRegion,network:$network}) {
-		app {
+mutation RemoveWireguardPeer ($input: RemoveWireGuardPeerInput!) {
+	removeWireGuardPeer(input: $input) {
+		organization {
 			name
-			status
-			autoscaling {
-				preferredRegion
-			}
-			organization {
-				id
-			}
-			network
 		}
 	}
-	updateAutoscaleConfig(input: {regions:$regions,appId:$id}) {
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func RestartAppMutation(
+	ctx context.Context,
+	client graphql.Client,
+	appId string,
+) (*RestartAppMutationResponse, error) {
+	__input := __RestartAppMutationInput{
+		AppId: appId,
+	}
+	var err error
+
+	var retval RestartAppMutationResponse
+	err = client.MakeRequest(
+		ctx,
+		"RestartAppMutation",
+		`
+mutation RestartAppMutation ($appId: ID!) {
+	restartApp(input: {appId:$appId}) {
 		app {
 			status
-			autoscaling {
-				regions {
-					code
-				}
-			}
 		}
 	}
 }
@@ -1873,42 +9710,26 @@ mutation CreateAppMutationWithAutoscaleConfig ($name: String, $id: ID!, $organiz
 	return &retval, err
 }
 
-func CreatePostgresCluster(
+func ResumeAppMutation(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-	orgid string,
-	region string,
-	password string,
-	vmsize string,
-	volumesize int,
-	count int,
-	imageref string,
-) (*CreatePostgresClusterResponse, error) {
-	__input := __CreatePostgresClusterInput{
-		Name:       name,
-		Orgid:      orgid,
-		Region:     region,
-		Password:   password,
-		Vmsize:     vmsize,
-		Volumesize: volumesize,
-		Count:      count,
-		Imageref:   imageref,
+	appId string,
+) (*ResumeAppMutationResponse, error) {
+	__input := __ResumeAppMutationInput{
+		AppId: appId,
 	}
 	var err error
 
-	var retval CreatePostgresClusterResponse
+	var retval ResumeAppMutationResponse
 	err = client.MakeRequest(
 		ctx,
-		"CreatePostgresCluster",
+		"ResumeAppMutation",
 		`
-mutation CreatePostgresCluster ($name: String, $orgid: ID!, $region: String, $password: String, $vmsize: String, $volumesize: Int, $count: Int, $imageref: String) {
-	createPostgresCluster(input: {name:$name,organizationId:$orgid,region:$region,password:$password,vmSize:$vmsize,volumeSizeGb:$volumesize,count:$count,imageRef:$imageref}) {
+mutation ResumeAppMutation ($appId: ID!) {
+	resumeApp(input: {appId:$appId}) {
 		app {
-			name
+			status
 		}
-		username
-		password
 	}
 }
 `,
@@ -1918,36 +9739,30 @@ mutation CreatePostgresCluster ($name: String, $orgid: ID!, $region: String, $pa
 	return &retval, err
 }
 
-func CreateVolume(
+func RevokePostgresClusterUserAccessMutation(
 	ctx context.Context,
 	client graphql.Client,
-	app string,
-	name string,
-	region string,
-	sizeGb int,
-) (*CreateVolumeResponse, error) {
-	__input := __CreateVolumeInput{
-		App:    app,
-		Name:   name,
-		Region: region,
-		SizeGb: sizeGb,
+	appName string,
+	username string,
+	databaseName string,
+) (*RevokePostgresClusterUserAccessMutationResponse, error) {
+	__input := __RevokePostgresClusterUserAccessMutationInput{
+		AppName:      appName,
+		Username:     username,
+		DatabaseName: databaseName,
 	}
 	var err error
 
-	var retval CreateVolumeResponse
+	var retval RevokePostgresClusterUserAccessMutationResponse
 	err = client.MakeRequest(
 		ctx,
-		"CreateVolume",
+		"RevokePostgresClusterUserAccessMutation",
 		`
-mutation CreateVolume ($app: ID!, $name: String!, $region: String!, $sizeGb: Int!) {
-	createVolume(input: {appId:$app,name:$name,region:$region,sizeGb:$sizeGb}) {
-		volume {
-			name
-			region
-			id
-			internalId
-			region
-			sizeGb
+mutation RevokePostgresClusterUserAccessMutation ($appName: String!, $username: String!, $databaseName: String!) {
+	revokePostgresClusterUserAccess(input: {appName:$appName,username:$username,databaseName:$databaseName}) {
+		user {
+			username
+			databases
 		}
 	}
 }
@@ -1958,25 +9773,28 @@ mutation CreateVolume ($app: ID!, $name: String!, $region: String!, $sizeGb: Int
 	return &retval, err
 }
 
-func DeleteAppMutation(
+func ScaleAppMutation(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-) (*DeleteAppMutationResponse, error) {
-	__input := __DeleteAppMutationInput{
-		Name: name,
+	appId string,
+	regions []ScaleRegionInput,
+) (*ScaleAppMutationResponse, error) {
+	__input := __ScaleAppMutationInput{
+		AppId:   appId,
+		Regions: regions,
 	}
 	var err error
 
-	var retval DeleteAppMutationResponse
+	var retval ScaleAppMutationResponse
 	err = client.MakeRequest(
 		ctx,
-		"DeleteAppMutation",
+		"ScaleAppMutation",
 		`
-mutation DeleteAppMutation ($name: ID!) {
-	deleteApp(appId: $name) {
-		organization {
-			name
+mutation ScaleAppMutation ($appId: ID!, $regions: [ScaleRegionInput!]!) {
+	scaleApp(input: {appId:$appId,regions:$regions}) {
+		placement {
+			region
+			count
 		}
 	}
 }
@@ -1987,31 +9805,32 @@ mutation DeleteAppMutation ($name: ID!) {
 	return &retval, err
 }
 
-func DeleteCertificate(
+func SetPagerdutyHandlerMutation(
 	ctx context.Context,
 	client graphql.Client,
-	app string,
-	hostname string,
-) (*DeleteCertificateResponse, error) {
-	__input := __DeleteCertificateInput{
-		App:      app,
-		Hostname: hostname,
+	organizationId string,
+	name string,
+	pagerdutyToken string,
+	pagerdutyStatusMap interface{},
+) (*SetPagerdutyHandlerMutationResponse, error) {
+	__input := __SetPagerdutyHandlerMutationInput{
+		OrganizationId:     organizationId,
+		Name:               name,
+		PagerdutyToken:     pagerdutyToken,
+		PagerdutyStatusMap: pagerdutyStatusMap,
 	}
 	var err error
 
-	var retval DeleteCertificateResponse
+	var retval SetPagerdutyHandlerMutationResponse
 	err = client.MakeRequest(
 		ctx,
-		"DeleteCertificate",
+		"SetPagerdutyHandlerMutation",
 		`
-mutation DeleteCertificate ($app: ID!, $hostname: String!) {
-	deleteCertificate(appId: $app, hostname: $hostname) {
-		app {
+mutation SetPagerdutyHandlerMutation ($organizationId: ID!, $name: String!, $pagerdutyToken: String!, $pagerdutyStatusMap: JSON) {
+	setPagerdutyHandler(input: {organizationId:$organizationId,name:$name,pagerdutyToken:$pagerdutyToken,pagerdutyStatusMap:$pagerdutyStatusMap}) {
+		handler {
 			name
-		}
-		certificate {
-			hostname
-			id
+			type
 		}
 	}
 }
@@ -2022,24 +9841,37 @@ mutation DeleteCertificate ($app: ID!, $hostname: String!) {
 	return &retval, err
 }
 
-func DeleteVolume(
+func SetSlackHandlerMutation(
 	ctx context.Context,
 	client graphql.Client,
-	volume string,
-) (*DeleteVolumeResponse, error) {
-	__input := __DeleteVolumeInput{
-		Volume: volume,
+	organizationId string,
+	name string,
+	slackWebhookUrl string,
+	slackChannel string,
+	slackUsername string,
+	slackIconUrl string,
+) (*SetSlackHandlerMutationResponse, error) {
+	__input := __SetSlackHandlerMutationInput{
+		OrganizationId:  organizationId,
+		Name:            name,
+		SlackWebhookUrl: slackWebhookUrl,
+		SlackChannel:    slackChannel,
+		SlackUsername:   slackUsername,
+		SlackIconUrl:    slackIconUrl,
 	}
 	var err error
 
-	var retval DeleteVolumeResponse
+	var retval SetSlackHandlerMutationResponse
 	err = client.MakeRequest(
 		ctx,
-		"DeleteVolume",
+		"SetSlackHandlerMutation",
 		`
-mutation DeleteVolume ($volume: ID!) {
-	deleteVolume(input: {volumeId:$volume}) {
-		clientMutationId
+mutation SetSlackHandlerMutation ($organizationId: ID!, $name: String!, $slackWebhookUrl: String!, $slackChannel: String, $slackUsername: String, $slackIconUrl: String) {
+	setSlackHandler(input: {organizationId:$organizationId,name:$name,slackWebhookUrl:$slackWebhookUrl,slackChannel:$slackChannel,slackUsername:$slackUsername,slackIconUrl:$slackIconUrl}) {
+		handler {
+			name
+			type
+		}
 	}
 }
 `,
@@ -2049,33 +9881,34 @@ mutation DeleteVolume ($volume: ID!) {
 	return &retval, err
 }
 
-func GetCertificate(
+func SetVmCountMutation(
 	ctx context.Context,
 	client graphql.Client,
-	app string,
-	hostname string,
-) (*GetCertificateResponse, error) {
-	__input := __GetCertificateInput{
-		App:      app,
-		Hostname: hostname,
+	appId string,
+	group string,
+	count int,
+	maxPerRegion int,
+) (*SetVmCountMutationResponse, error) {
+	__input := __SetVmCountMutationInput{
+		AppId:        appId,
+		Group:        group,
+		Count:        count,
+		MaxPerRegion: maxPerRegion,
 	}
 	var err error
 
-	var retval GetCertificateResponse
+	var retval SetVmCountMutationResponse
 	err = client.MakeRequest(
 		ctx,
-		"GetCertificate",
+		"SetVmCountMutation",
 		`
-query GetCertificate ($app: String!, $hostname: String!) {
-	app(name: $app) {
-		certificate(hostname: $hostname) {
-			id
-			dnsValidationInstructions
-			dnsValidationHostname
-			dnsValidationTarget
-			hostname
-			check
+mutation SetVmCountMutation ($appId: ID!, $group: String!, $count: Int!, $maxPerRegion: Int) {
+	setVmCount(input: {appId:$appId,groupCounts:[{group:$group,count:$count,maxPerRegion:$maxPerRegion}]}) {
+		taskGroupCounts {
+			name
+			count
 		}
+		warnings
 	}
 }
 `,
@@ -2085,60 +9918,32 @@ query GetCertificate ($app: String!, $hostname: String!) {
 	return &retval, err
 }
 
-func GetFullApp(
+func SetVmSizeMutation(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-) (*GetFullAppResponse, error) {
-	__input := __GetFullAppInput{
-		Name: name,
+	appId string,
+	sizeName string,
+	memoryMb int,
+	group string,
+) (*SetVmSizeMutationResponse, error) {
+	__input := __SetVmSizeMutationInput{
+		AppId:    appId,
+		SizeName: sizeName,
+		MemoryMb: memoryMb,
+		Group:    group,
 	}
 	var err error
 
-	var retval GetFullAppResponse
+	var retval SetVmSizeMutationResponse
 	err = client.MakeRequest(
 		ctx,
-		"GetFullApp",
+		"SetVmSizeMutation",
 		`
-query GetFullApp ($name: String) {
-	app(name: $name) {
-		name
-		network
-		organization {
-			id
-		}
-		autoscaling {
-			preferredRegion
-			regions {
-				code
-			}
-		}
-		appUrl
-		hostname
-		id
-		status
-		deployed
-		currentRelease {
-			id
-		}
-		config {
-			definition
-		}
-		healthChecks {
-			nodes {
-				name
-				status
-			}
-		}
-		ipAddresses {
-			nodes {
-				address
-				id
-			}
-		}
-		role {
-			__typename
+mutation SetVmSizeMutation ($appId: ID!, $sizeName: String!, $memoryMb: Int, $group: String) {
+	setVmSize(input: {appId:$appId,sizeName:$sizeName,memoryMb:$memoryMb,group:$group}) {
+		vmSize {
 			name
+			memoryMb
 		}
 	}
 }
@@ -2149,30 +9954,34 @@ query GetFullApp ($name: String) {
 	return &retval, err
 }
 
-func IpAddressQuery(
+func UpdateAutoScaleConfigMutation(
 	ctx context.Context,
 	client graphql.Client,
-	app string,
-	addr string,
-) (*IpAddressQueryResponse, error) {
-	__input := __IpAddressQueryInput{
-		App:  app,
-		Addr: addr,
+	id string,
+	regions []AutoscaleRegionConfigInput,
+	resetRegions bool,
+) (*UpdateAutoScaleConfigMutationResponse, error) {
+	__input := __UpdateAutoScaleConfigMutationInput{
+		Id:           id,
+		Regions:      regions,
+		ResetRegions: resetRegions,
 	}
 	var err error
 
-	var retval IpAddressQueryResponse
+	var retval UpdateAutoScaleConfigMutationResponse
 	err = client.MakeRequest(
 		ctx,
-		"IpAddressQuery",
+		"UpdateAutoScaleConfigMutation",
 		`
-query IpAddressQuery ($app: String, $addr: String!) {
-	app(name: $app) {
-		ipAddress(address: $addr) {
-			id
-			type
-			address
-			region
+mutation UpdateAutoScaleConfigMutation ($id: ID!, $regions: [AutoscaleRegionConfigInput!], $resetRegions: Boolean) {
+	updateAutoscaleConfig(input: {resetRegions:$resetRegions,regions:$regions,appId:$id}) {
+		app {
+			status
+			autoscaling {
+				regions {
+					code
+				}
+			}
 		}
 	}
 }
@@ -2183,51 +9992,69 @@ query IpAddressQuery ($app: String, $addr: String!) {
 	return &retval, err
 }
 
-func OrgsQuery(
+func UpdateAutoscaleSettingsMutation(
 	ctx context.Context,
 	client graphql.Client,
-) (*OrgsQueryResponse, error) {
+	appId string,
+	enabled *bool,
+	balanceRegions *bool,
+	minCount *int,
+	maxCount *int,
+	regions []AutoscaleRegionConfigInput,
+) (*UpdateAutoscaleSettingsMutationResponse, error) {
+	__input := __UpdateAutoscaleSettingsMutationInput{
+		AppId:          appId,
+		Enabled:        enabled,
+		BalanceRegions: balanceRegions,
+		MinCount:       minCount,
+		MaxCount:       maxCount,
+		Regions:        regions,
+	}
 	var err error
 
-	var retval OrgsQueryResponse
+	var retval UpdateAutoscaleSettingsMutationResponse
 	err = client.MakeRequest(
 		ctx,
-		"OrgsQuery",
+		"UpdateAutoscaleSettingsMutation",
 		`
-query OrgsQuery {
-	organizations {
-		nodes {
+mutation UpdateAutoscaleSettingsMutation ($appId: ID!, $enabled: Boolean, $balanceRegions: Boolean, $minCount: Int, $maxCount: Int, $regions: [AutoscaleRegionConfigInput!]) {
+	updateAutoscaleConfig(input: {appId:$appId,enabled:$enabled,balanceRegions:$balanceRegions,minCount:$minCount,maxCount:$maxCount,regions:$regions,resetRegions:false}) {
+		app {
 			name
-			id
 		}
 	}
 }
 `,
 		&retval,
-		nil,
+		&__input,
 	)
 	return &retval, err
 }
 
-func ReleaseIpAddress(
+func UpdateOrganizationMembership(
 	ctx context.Context,
 	client graphql.Client,
-	addressId string,
-) (*ReleaseIpAddressResponse, error) {
-	__input := __ReleaseIpAddressInput{
-		AddressId: addressId,
+	organizationId string,
+	userId string,
+	role OrganizationMemberRole,
+) (*UpdateOrganizationMembershipResponse, error) {
+	__input := __UpdateOrganizationMembershipInput{
+		OrganizationId: organizationId,
+		UserId:         userId,
+		Role:           role,
 	}
 	var err error
 
-	var retval ReleaseIpAddressResponse
+	var retval UpdateOrganizationMembershipResponse
 	err = client.MakeRequest(
 		ctx,
-		"ReleaseIpAddress",
+		"UpdateOrganizationMembership",
 		`
-mutation ReleaseIpAddress ($addressId: ID!) {
-	releaseIpAddress(input: {ipAddressId:$addressId}) {
-		app {
-			name
+mutation UpdateOrganizationMembership ($organizationId: ID!, $userId: ID!, $role: OrganizationMemberRole!) {
+	updateOrganizationMembership(input: {organizationId:$organizationId,userId:$userId,role:$role}) {
+		user {
+			id
+			email
 		}
 	}
 }
@@ -2238,26 +10065,25 @@ mutation ReleaseIpAddress ($addressId: ID!) {
 	return &retval, err
 }
 
-func RemoveWireguardPeer(
+func ValidateWireGuardPeers(
 	ctx context.Context,
 	client graphql.Client,
-	input RemoveWireGuardPeerInput,
-) (*RemoveWireguardPeerResponse, error) {
-	__input := __RemoveWireguardPeerInput{
-		Input: input,
+	peerIps []string,
+) (*ValidateWireGuardPeersResponse, error) {
+	__input := __ValidateWireGuardPeersInput{
+		PeerIps: peerIps,
 	}
 	var err error
 
-	var retval RemoveWireguardPeerResponse
+	var retval ValidateWireGuardPeersResponse
 	err = client.MakeRequest(
 		ctx,
-		"RemoveWireguardPeer",
+		"ValidateWireGuardPeers",
 		`
-mutation RemoveWireguardPeer ($input: RemoveWireGuardPeerInput!) {
-	removeWireGuardPeer(input: $input) {
-		organization {
-			name
-		}
+mutation ValidateWireGuardPeers ($peerIps: [String!]!) {
+	validateWireGuardPeers(input: {peerIps:$peerIps}) {
+		validPeerIps
+		invalidPeerIps
 	}
 }
 `,
@@ -2267,40 +10093,27 @@ mutation RemoveWireguardPeer ($input: RemoveWireGuardPeerInput!) {
 	return &retval, err
 }
 
-func UpdateAutoScaleConfigMutation(
+func ViewerQuery(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-	regions []AutoscaleRegionConfigInput,
-	resetRegions bool,
-) (*UpdateAutoScaleConfigMutationResponse, error) {
-	__input := __UpdateAutoScaleConfigMutationInput{
-		Id:           id,
-		Regions:      regions,
-		ResetRegions: resetRegions,
-	}
+) (*ViewerQueryResponse, error) {
 	var err error
 
-	var retval UpdateAutoScaleConfigMutationResponse
+	var retval ViewerQueryResponse
 	err = client.MakeRequest(
 		ctx,
-		"UpdateAutoScaleConfigMutation",
+		"ViewerQuery",
 		`
-mutation UpdateAutoScaleConfigMutation ($id: ID!, $regions: [AutoscaleRegionConfigInput!], $resetRegions: Boolean) {
-	updateAutoscaleConfig(input: {resetRegions:$resetRegions,regions:$regions,appId:$id}) {
-		app {
-			status
-			autoscaling {
-				regions {
-					code
-				}
-			}
-		}
+query ViewerQuery {
+	viewer {
+		id
+		email
+		name
 	}
 }
 `,
 		&retval,
-		&__input,
+		nil,
 	)
 	return &retval, err
 }
@@ -2340,3 +10153,71 @@ query VolumeQuery ($app: String, $internal: String!) {
 	)
 	return &retval, err
 }
+
+func WireguardPeerQuery(
+	ctx context.Context,
+	client graphql.Client,
+	org string,
+	name string,
+) (*WireguardPeerQueryResponse, error) {
+	__input := __WireguardPeerQueryInput{
+		Org:  org,
+		Name: name,
+	}
+	var err error
+
+	var retval WireguardPeerQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"WireguardPeerQuery",
+		`
+query WireguardPeerQuery ($org: ID!, $name: String!) {
+	organization(id: $org) {
+		wireGuardPeer(name: $name) {
+			id
+			name
+			network
+			peerip
+			pubkey
+			region
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
+
+func WireguardPeersQuery(
+	ctx context.Context,
+	client graphql.Client,
+	org string,
+) (*WireguardPeersQueryResponse, error) {
+	__input := __WireguardPeersQueryInput{
+		Org: org,
+	}
+	var err error
+
+	var retval WireguardPeersQueryResponse
+	err = client.MakeRequest(
+		ctx,
+		"WireguardPeersQuery",
+		`
+query WireguardPeersQuery ($org: ID!) {
+	organization(id: $org) {
+		wireGuardPeers {
+			nodes {
+				name
+				peerip
+			}
+		}
+	}
+}
+`,
+		&retval,
+		&__input,
+	)
+	return &retval, err
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyAppAutoscalingResourceType{}
+var _ tfsdk.Resource = flyAppAutoscalingResource{}
+var _ tfsdk.ResourceWithImportState = flyAppAutoscalingResource{}
+
+type flyAppAutoscalingResourceType struct{}
+
+type flyAppAutoscalingResource struct {
+	provider provider
+}
+
+type flyAppAutoscalingResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	App             types.String `tfsdk:"app"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	BalanceRegions  types.Bool   `tfsdk:"balance_regions"`
+	MinCount        types.Int64  `tfsdk:"min_count"`
+	MaxCount        types.Int64  `tfsdk:"max_count"`
+	Strategy        types.String `tfsdk:"strategy"`
+	RegionMinCounts types.Map    `tfsdk:"region_min_counts"`
+}
+
+func (t flyAppAutoscalingResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly app autoscaling resource. Only the settings that differ from the app's current config are sent, so settings made elsewhere are left alone.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Name of app",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"app": {
+				MarkdownDescription: "Name of app to configure",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"enabled": {
+				MarkdownDescription: "Whether autoscaling is enabled",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"balance_regions": {
+				MarkdownDescription: "Spread VMs evenly across regions",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"min_count": {
+				MarkdownDescription: "Minimum number of VMs",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"max_count": {
+				MarkdownDescription: "Maximum number of VMs",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"strategy": {
+				MarkdownDescription: "Autoscaling strategy the api derived from the settings",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"region_min_counts": {
+				MarkdownDescription: "Minimum number of VMs per region, keyed by region code. Removing a region resets its config. Regions other resources add without a min count are ignored",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.Int64Type},
+			},
+		},
+	}, nil
+}
+
+func (t flyAppAutoscalingResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyAppAutoscalingResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyAppAutoscalingResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyAppAutoscalingResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags = r.apply(ctx, data, types.Map{ElemType: types.Int64Type, Null: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppAutoscalingResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyAppAutoscalingResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.AppAutoscalingQuery(context.Background(), *r.provider.client, data.App.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	data = autoscalingDataFromQuery(data, query.App)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppAutoscalingResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan flyAppAutoscalingResourceData
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	var state flyAppAutoscalingResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.apply(ctx, plan, state.RegionMinCounts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppAutoscalingResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The app always has an autoscaling config, there is nothing to delete
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyAppAutoscalingResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app"), req.ID)...)
+}
+
+// apply sends the settings in plan that differ from the app's current config. priorRegions are the region min counts
+// terraform managed before, regions dropped from the plan since are reset.
+func (r flyAppAutoscalingResource) apply(ctx context.Context, plan flyAppAutoscalingResourceData, priorRegions types.Map) (flyAppAutoscalingResourceData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	query, err := graphql.AppAutoscalingQuery(context.Background(), *r.provider.client, plan.App.Value)
+	if err != nil {
+		diags.AddError("Query app failed", err.Error())
+		return plan, diags
+	}
+	current := query.App.Autoscaling
+
+	var enabled, balanceRegions *bool
+	var minCount, maxCount *int
+	if !plan.Enabled.Unknown && !plan.Enabled.Null && plan.Enabled.Value != current.Enabled {
+		enabled = &plan.Enabled.Value
+	}
+	if !plan.BalanceRegions.Unknown && !plan.BalanceRegions.Null && plan.BalanceRegions.Value != current.BalanceRegions {
+		balanceRegions = &plan.BalanceRegions.Value
+	}
+	if !plan.MinCount.Unknown && !plan.MinCount.Null && int(plan.MinCount.Value) != current.MinCount {
+		v := int(plan.MinCount.Value)
+		minCount = &v
+	}
+	if !plan.MaxCount.Unknown && !plan.MaxCount.Null && int(plan.MaxCount.Value) != current.MaxCount {
+		v := int(plan.MaxCount.Value)
+		maxCount = &v
+	}
+
+	existing := map[string]graphql.AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig{}
+	for _, region := range current.Regions {
+		existing[region.Code] = region
+	}
+
+	var regions []graphql.AutoscaleRegionConfigInput
+	if !plan.RegionMinCounts.Unknown {
+		for code, v := range plan.RegionMinCounts.Elems {
+			want := int(v.(types.Int64).Value)
+			region, ok := existing[code]
+			if ok && region.MinCount == want {
+				continue
+			}
+			input := graphql.AutoscaleRegionConfigInput{Code: code, MinCount: &want}
+			if ok {
+				// Weight is part of the same input, carry the current one over so it isn't zeroed
+				weight := region.Weight
+				input.Weight = &weight
+			}
+			regions = append(regions, input)
+		}
+		reset := true
+		for code := range priorRegions.Elems {
+			if _, ok := plan.RegionMinCounts.Elems[code]; !ok {
+				regions = append(regions, graphql.AutoscaleRegionConfigInput{Code: code, Reset: &reset})
+			}
+		}
+	}
+
+	if enabled != nil || balanceRegions != nil || minCount != nil || maxCount != nil || len(regions) > 0 {
+		_, err := graphql.UpdateAutoscaleSettingsMutation(context.Background(), *r.provider.client, plan.App.Value, enabled, balanceRegions, minCount, maxCount, regions)
+		if err != nil {
+			diags.AddError("Update autoscaling failed", err.Error())
+			return plan, diags
+		}
+	}
+
+	query, err = graphql.AppAutoscalingQuery(context.Background(), *r.provider.client, plan.App.Value)
+	if err != nil {
+		diags.AddError("Read after update failed", err.Error())
+		return plan, diags
+	}
+	return autoscalingDataFromQuery(plan, query.App), diags
+}
+
+// autoscalingDataFromQuery maps an app's autoscaling config onto resource data. Region min counts are only tracked when
+// prior has them, and then every region is reported so regions added outside terraform show up as drift.
+func autoscalingDataFromQuery(prior flyAppAutoscalingResourceData, app graphql.AppAutoscalingQueryApp) flyAppAutoscalingResourceData {
+	data := flyAppAutoscalingResourceData{
+		Id:              types.String{Value: app.Name},
+		App:             types.String{Value: app.Name},
+		Enabled:         types.Bool{Value: app.Autoscaling.Enabled},
+		BalanceRegions:  types.Bool{Value: app.Autoscaling.BalanceRegions},
+		MinCount:        types.Int64{Value: int64(app.Autoscaling.MinCount)},
+		MaxCount:        types.Int64{Value: int64(app.Autoscaling.MaxCount)},
+		Strategy:        types.String{Value: strings.ToLower(string(app.Autoscaling.Strategy))},
+		RegionMinCounts: types.Map{ElemType: types.Int64Type, Null: true},
+	}
+
+	if !prior.RegionMinCounts.Null && !prior.RegionMinCounts.Unknown {
+		// fly_app and fly_app_regions add regions with a zero min count too, those aren't ours to report or reset
+		elems := map[string]attr.Value{}
+		for _, region := range app.Autoscaling.Regions {
+			if _, ok := prior.RegionMinCounts.Elems[region.Code]; ok || region.MinCount != 0 {
+				elems[region.Code] = types.Int64{Value: int64(region.MinCount)}
+			}
+		}
+		data.RegionMinCounts = types.Map{ElemType: types.Int64Type, Elems: elems}
+	}
+
+	return data
+}
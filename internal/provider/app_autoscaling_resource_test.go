@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"dov.dev/fly/fly-provider/graphql"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAutoscalingDataFromQueryRegionMinCounts(t *testing.T) {
+	app := graphql.AppAutoscalingQueryApp{
+		Name: "hello",
+		Autoscaling: graphql.AppAutoscalingQueryAppAutoscalingAutoscalingConfig{
+			Regions: []graphql.AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig{
+				{Code: "ewr", MinCount: 2},
+				// Added by fly_app regions or fly_app_regions
+				{Code: "lhr", MinCount: 0},
+				// Someone set a min count outside terraform
+				{Code: "syd", MinCount: 1},
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		prior types.Map
+		want  map[string]int64
+	}{
+		{
+			name:  "not managed",
+			prior: types.Map{ElemType: types.Int64Type, Null: true},
+		},
+		{
+			name:  "ignores regions with no min count",
+			prior: minCounts(map[string]int64{"ewr": 2}),
+			want:  map[string]int64{"ewr": 2, "syd": 1},
+		},
+		{
+			name:  "keeps tracked regions at zero",
+			prior: minCounts(map[string]int64{"ewr": 2, "lhr": 1}),
+			want:  map[string]int64{"ewr": 2, "lhr": 0, "syd": 1},
+		},
+		{
+			name:  "drops tracked regions that are gone",
+			prior: minCounts(map[string]int64{"ewr": 2, "ord": 1}),
+			want:  map[string]int64{"ewr": 2, "syd": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := autoscalingDataFromQuery(flyAppAutoscalingResourceData{RegionMinCounts: tt.prior}, app)
+			if tt.want == nil {
+				if !data.RegionMinCounts.Null {
+					t.Fatalf("expected null region_min_counts, got %v", data.RegionMinCounts)
+				}
+				return
+			}
+			if !data.RegionMinCounts.Equal(minCounts(tt.want)) {
+				t.Fatalf("expected %v, got %v", tt.want, data.RegionMinCounts.Elems)
+			}
+		})
+	}
+}
+
+func minCounts(counts map[string]int64) types.Map {
+	elems := map[string]attr.Value{}
+	for code, count := range counts {
+		elems[code] = types.Int64{Value: count}
+	}
+	return types.Map{ElemType: types.Int64Type, Elems: elems}
+}
@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"math"
+	"strings"
+	"time"
+)
+
+var _ tfsdk.ResourceType = flyAppConfigResourceType{}
+var _ tfsdk.Resource = flyAppConfigResource{}
+var _ tfsdk.ResourceWithImportState = flyAppConfigResource{}
+var _ tfsdk.ResourceWithModifyPlan = flyAppConfigResource{}
+
+type flyAppConfigResourceType struct{}
+
+type flyAppConfigResource struct {
+	provider provider
+}
+
+type flyAppConfigResourceData struct {
+	Id         types.String `tfsdk:"id"`
+	App        types.String `tfsdk:"app"`
+	Config     types.String `tfsdk:"config"`
+	Definition types.String `tfsdk:"definition"`
+}
+
+func (t flyAppConfigResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly app config resource, deploys a fly.toml using the image of the app's current release. Configs are compared by content, so formatting changes don't cause a deploy.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Name of app",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"app": {
+				MarkdownDescription: "Name of app to configure",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"config": {
+				MarkdownDescription: "Contents of fly.toml, e.g. `file(\"fly.toml\")`. Validated against the api at plan time",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"definition": {
+				MarkdownDescription: "Normalized JSON definition the app is running with",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t flyAppConfigResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyAppConfigResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyAppConfigResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyAppConfigResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags = r.deploy(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppConfigResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyAppConfigResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.AppConfigQuery(context.Background(), *r.provider.client, data.App.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	definition, err := normalizeDefinition(query.App.Config.Definition)
+	if err != nil {
+		resp.Diagnostics.AddError("Read: invalid definition", err.Error())
+		return
+	}
+
+	// Changed outside terraform, render what is running so the plan shows the difference and deploys ours again
+	if definition != data.Definition.Value {
+		config, err := definitionToml(query.App.Config.Definition)
+		if err != nil {
+			resp.Diagnostics.AddError("Read: could not render definition as toml", err.Error())
+			return
+		}
+		data.Config = types.String{Value: config}
+		data.Definition = types.String{Value: definition}
+	}
+	data.Id = types.String{Value: data.App.Value}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppConfigResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data flyAppConfigResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	var state flyAppConfigResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only formatting changed, nothing to deploy
+	if sameFlyToml(data.Config.Value, state.Config.Value) {
+		data.Id = state.Id
+		data.Definition = state.Definition
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data, diags = r.deploy(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppConfigResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// An app always has a config, removing the resource only stops managing it
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyAppConfigResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app"), req.ID)...)
+}
+
+// ModifyPlan validates the config with the api and keeps the known definition when only formatting changed
+func (r flyAppConfigResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan flyAppConfigResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.Config.Unknown {
+		return
+	}
+
+	configPath := tftypes.NewAttributePath().WithAttributeName("config")
+
+	parsed, err := parseFlyToml(plan.Config.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(configPath, "Invalid fly.toml", err.Error())
+		return
+	}
+
+	// The app may not exist yet when it is created in the same apply, validation then happens on create
+	if !plan.App.Unknown {
+		query, err := graphql.ParseConfigQuery(context.Background(), *r.provider.client, plan.App.Value, parsed)
+		if err != nil && !utils.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(configPath, "Validate config failed", err.Error())
+			return
+		}
+		if err == nil && !query.App.ParseConfig.Valid {
+			resp.Diagnostics.AddAttributeError(configPath, "Invalid fly.toml", strings.Join(query.App.ParseConfig.Errors, "\n"))
+			return
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state flyAppConfigResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Config itself must stay as written, Update skips the deploy for these
+	if sameFlyToml(plan.Config.Value, state.Config.Value) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("definition"), state.Definition)...)
+	}
+}
+
+// deploy validates the config and releases it with the image the app is currently running
+func (r flyAppConfigResource) deploy(ctx context.Context, data flyAppConfigResourceData) (flyAppConfigResourceData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parsed, err := parseFlyToml(data.Config.Value)
+	if err != nil {
+		diags.AddError("Invalid fly.toml", err.Error())
+		return data, diags
+	}
+
+	validated, err := graphql.ParseConfigQuery(context.Background(), *r.provider.client, data.App.Value, parsed)
+	if err != nil {
+		diags.AddError("Validate config failed", err.Error())
+		return data, diags
+	}
+	if !validated.App.ParseConfig.Valid {
+		diags.AddError("Invalid fly.toml", strings.Join(validated.App.ParseConfig.Errors, "\n"))
+		return data, diags
+	}
+
+	release, err := graphql.CurrentReleaseQuery(context.Background(), *r.provider.client, data.App.Value)
+	if err != nil {
+		diags.AddError("Query current release failed", err.Error())
+		return data, diags
+	}
+	if release.App.CurrentRelease.ImageRef == "" {
+		diags.AddError("App has no image to deploy the config with", "Deploy an image first, e.g. with fly_deployment")
+		return data, diags
+	}
+
+	deployed, err := graphql.DeployImageMutation(context.Background(), *r.provider.client, data.App.Value, release.App.CurrentRelease.ImageRef, validated.App.ParseConfig.Definition, "")
+	if err != nil {
+		diags.AddError("Deploy config failed", err.Error())
+		return data, diags
+	}
+	_, err = r.provider.waitForDeployment(ctx, data.App.Value, deployed.DeployImage.Release.EvaluationId, defaultDeploymentTimeout*time.Second)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Deployment of release v%d failed", deployed.DeployImage.Release.Version), err.Error())
+		return data, diags
+	}
+
+	query, err := graphql.AppConfigQuery(context.Background(), *r.provider.client, data.App.Value)
+	if err != nil {
+		diags.AddError("Read after deploy failed", err.Error())
+		return data, diags
+	}
+	definition, err := normalizeDefinition(query.App.Config.Definition)
+	if err != nil {
+		diags.AddError("Read after deploy failed", err.Error())
+		return data, diags
+	}
+
+	data.Id = types.String{Value: data.App.Value}
+	data.Definition = types.String{Value: definition}
+	return data, diags
+}
+
+// parseFlyToml decodes a fly.toml, syntax errors point at the offending line
+func parseFlyToml(config string) (map[string]interface{}, error) {
+	definition := map[string]interface{}{}
+	if _, err := toml.Decode(config, &definition); err != nil {
+		var parseErr toml.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, errors.New(parseErr.ErrorWithPosition())
+		}
+		return nil, err
+	}
+	return definition, nil
+}
+
+// sameFlyToml reports whether two fly.toml documents parse to the same definition
+func sameFlyToml(a string, b string) bool {
+	parsedA, err := parseFlyToml(a)
+	if err != nil {
+		return false
+	}
+	parsedB, err := parseFlyToml(b)
+	if err != nil {
+		return false
+	}
+	jsonA, err := normalizeDefinition(parsedA)
+	if err != nil {
+		return false
+	}
+	jsonB, err := normalizeDefinition(parsedB)
+	return err == nil && jsonA == jsonB
+}
+
+// normalizeDefinition renders a definition as compact JSON with sorted keys, so equal configs compare equal as strings
+func normalizeDefinition(definition interface{}) (string, error) {
+	// Round trip through JSON first so toml ints and JSON floats end up the same type
+	b, err := json.Marshal(definition)
+	if err != nil {
+		return "", err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return "", err
+	}
+	b, err = json.Marshal(generic)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// definitionToml renders a definition returned by the api as fly.toml
+func definitionToml(definition interface{}) (string, error) {
+	buf := new(bytes.Buffer)
+	if err := toml.NewEncoder(buf).Encode(tomlValue(definition)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// tomlValue turns whole JSON numbers back into ints, otherwise ports would come out as 8080.0
+func tomlValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			out[k] = tomlValue(e)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = tomlValue(e)
+		}
+		return out
+	case float64:
+		if v == math.Trunc(v) {
+			return int64(v)
+		}
+		return v
+	default:
+		return v
+	}
+}
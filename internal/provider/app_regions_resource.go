@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/provider/modifiers"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"sort"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyAppRegionsResourceType{}
+var _ tfsdk.Resource = flyAppRegionsResource{}
+var _ tfsdk.ResourceWithImportState = flyAppRegionsResource{}
+
+type flyAppRegionsResourceType struct{}
+
+type flyAppRegionsResource struct {
+	provider provider
+}
+
+type flyAppRegionsResourceData struct {
+	Id            types.String `tfsdk:"id"`
+	App           types.String `tfsdk:"app"`
+	Group         types.String `tfsdk:"group"`
+	AllowRegions  types.Set    `tfsdk:"allow_regions"`
+	DenyRegions   types.Set    `tfsdk:"deny_regions"`
+	BackupRegions types.Set    `tfsdk:"backup_regions"`
+}
+
+func (t flyAppRegionsResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly app region placement for a process group, the terraform equivalent of `flyctl regions`. Deleting it leaves placement as it is.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "app/group",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"app": {
+				MarkdownDescription: "Name of app",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"group": {
+				MarkdownDescription: "Process group to place, defaults to app",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					modifiers.StringDefault("app"),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"allow_regions": {
+				MarkdownDescription: "Regions the group may run in. Regions currently allowed but not listed are denied",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.SetType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"deny_regions": {
+				MarkdownDescription: "Regions the group may never run in. Regions removed from it are allowed again, unless `allow_regions` is set and leaves them out",
+				Optional:            true,
+				Type:                types.SetType{ElemType: types.StringType},
+			},
+			"backup_regions": {
+				MarkdownDescription: "Regions to fall back to when the allowed ones are having issues",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.SetType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyAppRegionsResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyAppRegionsResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyAppRegionsResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyAppRegionsResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags = r.apply(ctx, data, flyAppRegionsResourceData{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppRegionsResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyAppRegionsResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.AppRegionsQuery(context.Background(), *r.provider.client, data.App.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	data = appRegionsDataFromQuery(data, query.App)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppRegionsResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data flyAppRegionsResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state flyAppRegionsResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags = r.apply(ctx, data, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppRegionsResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Denying every region would stop the app, so removing the resource only stops managing placement
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyAppRegionsResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if parts[0] == "" || (len(parts) == 2 && parts[1] == "") {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected app or app/group, got %q", req.ID))
+		return
+	}
+	group := "app"
+	if len(parts) == 2 {
+		group = parts[1]
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), parts[0]+"/"+group)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("group"), group)...)
+}
+
+// apply configures placement from plan. Like flyctl regions set, regions that are allowed now but missing from
+// allow_regions are denied. Regions dropped from deny_regions since prior are allowed again.
+func (r flyAppRegionsResource) apply(ctx context.Context, plan flyAppRegionsResourceData, prior flyAppRegionsResourceData) (flyAppRegionsResourceData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	query, err := graphql.AppRegionsQuery(context.Background(), *r.provider.client, plan.App.Value)
+	if err != nil {
+		diags.AddError("Query app failed", err.Error())
+		return plan, diags
+	}
+	current := appRegionsDataFromQuery(plan, query.App)
+
+	var allow, deny, backup []string
+	if !plan.AllowRegions.Unknown && !plan.AllowRegions.Null && !plan.AllowRegions.Equal(current.AllowRegions) {
+		allow = setStrings(plan.AllowRegions)
+		wanted := map[string]bool{}
+		for _, code := range allow {
+			wanted[code] = true
+		}
+		for _, code := range setStrings(current.AllowRegions) {
+			if !wanted[code] {
+				deny = append(deny, code)
+			}
+		}
+	}
+	if !plan.DenyRegions.Unknown && !plan.DenyRegions.Null {
+		deny = append(deny, setStrings(plan.DenyRegions)...)
+	}
+	if !plan.DenyRegions.Unknown && (plan.AllowRegions.Null || plan.AllowRegions.Unknown) {
+		// Denied regions can't be read back, so the api keeps denying them until told otherwise. With allow_regions
+		// set this is already covered, it lists everything that should be allowed.
+		stillDenied := map[string]bool{}
+		for _, code := range setStrings(plan.DenyRegions) {
+			stillDenied[code] = true
+		}
+		for _, code := range setStrings(prior.DenyRegions) {
+			if !stillDenied[code] {
+				allow = append(allow, code)
+			}
+		}
+	}
+	if !plan.BackupRegions.Unknown && !plan.BackupRegions.Null && !plan.BackupRegions.Equal(current.BackupRegions) {
+		// Non nil even when empty, an empty list clears the backup regions
+		backup = append([]string{}, setStrings(plan.BackupRegions)...)
+	}
+
+	if allow != nil || deny != nil || backup != nil {
+		_, err := graphql.ConfigureRegionsMutation(context.Background(), *r.provider.client, plan.App.Value, allow, deny, backup, apiGroup(query.App, plan.Group.Value))
+		if err != nil {
+			diags.AddError("Configure regions failed", err.Error())
+			return plan, diags
+		}
+	}
+
+	query, err = graphql.AppRegionsQuery(context.Background(), *r.provider.client, plan.App.Value)
+	if err != nil {
+		diags.AddError("Read after update failed", err.Error())
+		return plan, diags
+	}
+	return appRegionsDataFromQuery(plan, query.App), diags
+}
+
+// apiGroup returns the group name to send, apps without a [processes] section only have the implicit app group which
+// the api doesn't accept by name
+func apiGroup(app graphql.AppRegionsQueryApp, group string) string {
+	for _, pg := range app.ProcessGroups {
+		if pg.Name == group {
+			return group
+		}
+	}
+	return ""
+}
+
+// appRegionsDataFromQuery maps the placement of group onto resource data. Denied regions can't be read back, so they
+// are carried over from prior.
+func appRegionsDataFromQuery(prior flyAppRegionsResourceData, app graphql.AppRegionsQueryApp) flyAppRegionsResourceData {
+	group := prior.Group.Value
+	if group == "" {
+		group = "app"
+	}
+	inGroup := func(processGroup string) bool {
+		return processGroup == group || (processGroup == "" && group == "app")
+	}
+
+	var allow, backup []string
+	for _, region := range app.Regions {
+		if inGroup(region.ProcessGroup) {
+			allow = append(allow, region.Code)
+		}
+	}
+	for _, region := range app.BackupRegions {
+		if inGroup(region.ProcessGroup) {
+			backup = append(backup, region.Code)
+		}
+	}
+
+	deny := prior.DenyRegions
+	if deny.Unknown {
+		deny = types.Set{ElemType: types.StringType, Null: true}
+	}
+
+	return flyAppRegionsResourceData{
+		Id:            types.String{Value: app.Name + "/" + group},
+		App:           types.String{Value: app.Name},
+		Group:         types.String{Value: group},
+		AllowRegions:  stringSet(allow),
+		DenyRegions:   deny,
+		BackupRegions: stringSet(backup),
+	}
+}
+
+func setStrings(set types.Set) []string {
+	var values []string
+	for _, v := range set.Elems {
+		values = append(values, v.(types.String).Value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// stringSet builds a set attribute value, unlike stringList no values is an empty set so an emptied list isn't a diff
+func stringSet(values []string) types.Set {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.String{Value: v})
+	}
+	return types.Set{ElemType: types.StringType, Elems: elems}
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = appReleaseDataSourceType{}
+var _ tfsdk.DataSource = appReleaseDataSource{}
+
+type appReleaseDataSourceType struct{}
+
+// Matches getSchema
+type appReleaseDataSourceOutput struct {
+	Id          types.String `tfsdk:"id"`
+	App         types.String `tfsdk:"app"`
+	Version     types.Int64  `tfsdk:"version"`
+	Status      types.String `tfsdk:"status"`
+	Reason      types.String `tfsdk:"reason"`
+	Description types.String `tfsdk:"description"`
+	Stable      types.Bool   `tfsdk:"stable"`
+	Image       types.String `tfsdk:"image"`
+	User        types.String `tfsdk:"user"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func releaseAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"id": {
+			MarkdownDescription: "ID of release",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+		"app": {
+			MarkdownDescription: "Name of app",
+			Required:            true,
+			Type:                types.StringType,
+		},
+		"version": {
+			MarkdownDescription: "Version of release",
+			Computed:            true,
+			Type:                types.Int64Type,
+		},
+		"status": {
+			MarkdownDescription: "Status of release, e.g. succeeded or failed",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+		"reason": {
+			MarkdownDescription: "Why the release was made, e.g. deploy or change_image",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+		"description": {
+			MarkdownDescription: "Description of release",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+		"stable": {
+			MarkdownDescription: "Whether the release is stable",
+			Computed:            true,
+			Type:                types.BoolType,
+		},
+		"image": {
+			MarkdownDescription: "Image the release runs",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+		"user": {
+			MarkdownDescription: "Email of the user who made the release",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+		"created_at": {
+			MarkdownDescription: "When the release was made, RFC3339 formatted",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+	}
+}
+
+func (t appReleaseDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := releaseAttributes()
+	attributes["version"] = tfsdk.Attribute{
+		MarkdownDescription: "Version of release, defaults to the current release",
+		Optional:            true,
+		Computed:            true,
+		Type:                types.Int64Type,
+	}
+
+	return tfsdk.Schema{
+		MarkdownDescription: "Retrieve a release of an app, the current one unless a version is given",
+		Attributes:          attributes,
+	}, nil
+}
+
+func (t appReleaseDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appReleaseDataSource{
+		provider: provider,
+	}, diags
+}
+
+func (d appReleaseDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data appReleaseDataSourceOutput
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var release graphql.ReleaseFragment
+	if data.Version.Null {
+		query, err := graphql.CurrentReleaseQuery(context.Background(), *d.provider.client, data.App.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Query failed", err.Error())
+			return
+		}
+		release = query.App.CurrentRelease.ReleaseFragment
+	} else {
+		query, err := graphql.ReleaseQuery(context.Background(), *d.provider.client, data.App.Value, int(data.Version.Value))
+		if err != nil {
+			resp.Diagnostics.AddError("Query failed", err.Error())
+			return
+		}
+		release = query.App.Release.ReleaseFragment
+	}
+	if release.Id == "" && data.Version.Null {
+		resp.Diagnostics.AddError("Release not found", "App "+data.App.Value+" has not been released yet")
+		return
+	} else if release.Id == "" {
+		resp.Diagnostics.AddError("Release not found", "App "+data.App.Value+" has no release v"+strconv.FormatInt(data.Version.Value, 10))
+		return
+	}
+
+	app := data.App
+	data = releaseOutput(release)
+	data.App = app
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
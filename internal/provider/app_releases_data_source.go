@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = appReleasesDataSourceType{}
+var _ tfsdk.DataSource = appReleasesDataSource{}
+
+type appReleasesDataSourceType struct{}
+
+// Matches getSchema
+type appReleasesDataSourceOutput struct {
+	Id       types.String                 `tfsdk:"id"`
+	App      types.String                 `tfsdk:"app"`
+	Limit    types.Int64                  `tfsdk:"limit"`
+	Releases []appReleaseDataSourceOutput `tfsdk:"releases"`
+}
+
+const defaultReleasesLimit = 25
+
+func (t appReleasesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := releaseAttributes()
+	attributes["app"] = tfsdk.Attribute{
+		MarkdownDescription: "Name of app",
+		Computed:            true,
+		Type:                types.StringType,
+	}
+
+	return tfsdk.Schema{
+		MarkdownDescription: "Retrieve the releases of an app, newest first",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Name of app",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"app": {
+				MarkdownDescription: "Name of app",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"limit": {
+				MarkdownDescription: "Maximum number of releases to return. Defaults to 25",
+				Optional:            true,
+				Type:                types.Int64Type,
+			},
+			"releases": {
+				MarkdownDescription: "Releases of the app",
+				Computed:            true,
+				Attributes:          tfsdk.ListNestedAttributes(attributes, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t appReleasesDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appReleasesDataSource{
+		provider: provider,
+	}, diags
+}
+
+func (d appReleasesDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data appReleasesDataSourceOutput
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := defaultReleasesLimit
+	if !data.Limit.Null {
+		limit = int(data.Limit.Value)
+	}
+
+	query, err := graphql.ReleasesQuery(context.Background(), *d.provider.client, data.App.Value, limit)
+	if err != nil {
+		resp.Diagnostics.AddError("Query failed", err.Error())
+		return
+	}
+
+	data.Id = data.App
+	data.Releases = []appReleaseDataSourceOutput{}
+	for _, release := range query.App.Releases.Nodes {
+		output := releaseOutput(release.ReleaseFragment)
+		output.App = data.App
+		data.Releases = append(data.Releases, output)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// releaseOutput maps a release returned by the api, shared by both release data sources
+func releaseOutput(release graphql.ReleaseFragment) appReleaseDataSourceOutput {
+	return appReleaseDataSourceOutput{
+		Id:          types.String{Value: release.Id},
+		Version:     types.Int64{Value: int64(release.Version)},
+		Status:      types.String{Value: release.Status},
+		Reason:      types.String{Value: release.Reason},
+		Description: types.String{Value: release.Description},
+		Stable:      types.Bool{Value: release.Stable},
+		Image:       types.String{Value: release.ImageRef},
+		User:        types.String{Value: release.User.Email},
+		CreatedAt:   types.String{Value: release.CreatedAt.Format(time.RFC3339)},
+	}
+}
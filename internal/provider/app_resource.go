@@ -7,6 +7,7 @@ import (
 	"dov.dev/fly/fly-provider/internal/utils"
 	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,16 +20,19 @@ import (
 var _ tfsdk.ResourceType = flyAppResourceType{}
 var _ tfsdk.Resource = flyAppResource{}
 var _ tfsdk.ResourceWithImportState = flyAppResource{}
+var _ tfsdk.ResourceWithModifyPlan = flyAppResource{}
 
 type flyAppResourceType struct{}
 
 type flyAppResourceData struct {
-	Name            types.String   `tfsdk:"name"`
-	Id              types.String   `tfsdk:"id"`
-	Network         types.String   `tfsdk:"network"`
-	Org             types.String   `tfsdk:"org"`
-	PreferredRegion types.String   `tfsdk:"preferred_region"'`
-	Regions         []types.String `tfsdk:"regions"`
+	Name            types.String `tfsdk:"name"`
+	Id              types.String `tfsdk:"id"`
+	Network         types.String `tfsdk:"network"`
+	Org             types.String `tfsdk:"org"`
+	PreferredRegion types.String `tfsdk:"preferred_region"`
+	Regions         types.List   `tfsdk:"regions"`
+	Suspended       types.Bool   `tfsdk:"suspended"`
+	RestartTrigger  types.Map    `tfsdk:"restart_trigger"`
 }
 
 func (ar flyAppResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -41,12 +45,18 @@ func (ar flyAppResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diag
 				MarkdownDescription: "Name of application",
 				Required:            true,
 				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
 			},
 			"id": {
 				MarkdownDescription: "Name of application",
 				Optional:            true,
 				Computed:            true,
 				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"network": {
 				Computed:            true,
@@ -54,21 +64,26 @@ func (ar flyAppResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diag
 				MarkdownDescription: "Optional custom network ID",
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					modifiers.StringDefault(""),
+					tfsdk.RequiresReplace(),
 				},
 				Type: types.StringType,
 			},
 			"org": {
 				Computed:            true,
 				Optional:            true,
-				MarkdownDescription: "Optional org ID to operate upon",
+				MarkdownDescription: "Optional org slug or ID to operate upon, defaults to the provider org. Changing it moves the app in place",
 				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"preferred_region": {
 				Computed:            true,
 				Optional:            true,
-				MarkdownDescription: "Optional region to set as preferred",
+				MarkdownDescription: "Optional region to set as preferred. The fly api only accepts it on create, so changing it replaces the app",
 				PlanModifiers: tfsdk.AttributePlanModifiers{
-					modifiers.StringDefault(""),
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
 				},
 				Type: types.StringType,
 			},
@@ -76,7 +91,24 @@ func (ar flyAppResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diag
 				Computed:            true,
 				Optional:            true,
 				MarkdownDescription: "Optional list of regions to set in autoscaling config",
-				Type:                types.ListType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.ListType{ElemType: types.StringType},
+			},
+			"suspended": {
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Pause the app, scaling it to zero, or resume it",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.BoolType,
+			},
+			"restart_trigger": {
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that restart the app when changed, e.g. a secret version",
+				Type:                types.MapType{ElemType: types.StringType},
 			},
 		},
 	}, nil
@@ -104,45 +136,38 @@ func (r flyAppResource) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		return
 	}
 
-	if data.Org.Unknown {
-		defaultOrg, err := utils.GetDefaultOrg(*r.provider.client)
-		if err != nil {
-			resp.Diagnostics.AddError("Could not detect default organization", err.Error())
-			return
-		}
-		data.Org.Value = defaultOrg.Id
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not detect default organization", err.Error())
+		return
 	}
 
-	if len(data.Regions) > 0 {
-		var rawRegions []graphql.AutoscaleRegionConfigInput
-		var regions []types.String
+	suspended := data.Suspended
+	restartTrigger := data.RestartTrigger
 
-		for _, s := range data.Regions {
-			rawRegions = append(rawRegions, graphql.AutoscaleRegionConfigInput{
-				Code: s.Value,
-			})
-		}
+	if !data.Regions.Unknown && len(data.Regions.Elems) > 0 {
+		var regions []string
 
-		mresp, err := graphql.CreateAppMutationWithAutoscaleConfig(context.Background(), *r.provider.client, data.Name.Value, data.Name.Value, data.Org.Value, data.PreferredRegion.Value, data.Network.Value, rawRegions)
+		mresp, err := graphql.CreateAppMutationWithAutoscaleConfig(context.Background(), *r.provider.client, data.Name.Value, data.Name.Value, orgId, data.PreferredRegion.Value, data.Network.Value, regionConfigInput(data.Regions))
 		if err != nil {
 			resp.Diagnostics.AddError("Create app failed (creating with autoscale config)", err.Error())
 			return
 		}
 
 		for _, s := range mresp.UpdateAutoscaleConfig.App.Autoscaling.Regions {
-			regions = append(regions, types.String{Value: s.Code})
+			regions = append(regions, s.Code)
 		}
 
 		data = flyAppResourceData{
 			Id:              types.String{Value: mresp.CreateApp.App.Name},
 			Network:         types.String{Value: mresp.CreateApp.App.Network},
-			Org:             types.String{Value: mresp.CreateApp.App.Organization.Id},
+			Org:             orgValue(data.Org, mresp.CreateApp.App.Organization.Id, mresp.CreateApp.App.Organization.Slug),
 			Name:            types.String{Value: mresp.CreateApp.App.Name},
 			PreferredRegion: types.String{Value: mresp.CreateApp.App.Autoscaling.PreferredRegion},
-			Regions:         regions,
+			Regions:         stringList(regions),
 		}
 	} else {
-		mresp, err := graphql.CreateAppMutation(context.Background(), *r.provider.client, data.Name.Value, data.Org.Value, data.PreferredRegion.Value, data.Network.Value)
+		mresp, err := graphql.CreateAppMutation(context.Background(), *r.provider.client, data.Name.Value, orgId, data.PreferredRegion.Value, data.Network.Value)
 		if err != nil {
 			resp.Diagnostics.AddError("Create app failed", err.Error())
 			return
@@ -150,9 +175,21 @@ func (r flyAppResource) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		data = flyAppResourceData{
 			Id:              types.String{Value: mresp.CreateApp.App.Name},
 			Network:         types.String{Value: mresp.CreateApp.App.Network},
-			Org:             types.String{Value: mresp.CreateApp.App.Organization.Id},
+			Org:             orgValue(data.Org, mresp.CreateApp.App.Organization.Id, mresp.CreateApp.App.Organization.Slug),
 			Name:            types.String{Value: mresp.CreateApp.App.Name},
 			PreferredRegion: types.String{Value: mresp.CreateApp.App.Autoscaling.PreferredRegion},
+			Regions:         types.List{ElemType: types.StringType, Null: true},
+		}
+	}
+
+	data.Suspended = types.Bool{Value: false}
+	data.RestartTrigger = restartTrigger
+	if !suspended.Unknown && suspended.Value {
+		_, err := graphql.PauseAppMutation(context.Background(), *r.provider.client, data.Name.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Pause app failed", err.Error())
+		} else {
+			data.Suspended = types.Bool{Value: true}
 		}
 	}
 
@@ -173,39 +210,23 @@ func (r flyAppResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 		return
 	}
 
-	var appKey string
-	if !data.Name.Unknown {
-		appKey = data.Id.Value
-	} else {
-		appKey = data.Name.Value
+	query, err := graphql.GetFullApp(context.Background(), *r.provider.client, data.appKey())
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
 	}
-
-	query, err := graphql.GetFullApp(context.Background(), *r.provider.client, appKey)
 	var errList gqlerror.List
 	if errors.As(err, &errList) {
 		for _, err := range errList {
-			if err.Message == "Could not resolve " {
-				return
-			}
 			resp.Diagnostics.AddError(err.Message, err.Path.String())
 		}
+		return
 	} else if err != nil {
 		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
 	}
 
-	var regions []types.String
-	for _, s := range query.App.Autoscaling.Regions {
-		regions = append(regions, types.String{Value: s.Code})
-	}
-
-	data = flyAppResourceData{
-		Name:            types.String{Value: query.App.Name},
-		Id:              types.String{Value: query.App.Name},
-		Network:         types.String{Value: query.App.Network},
-		Org:             types.String{Value: query.App.Organization.Id},
-		PreferredRegion: types.String{Value: query.App.Autoscaling.PreferredRegion},
-		Regions:         regions,
-	}
+	data = appDataFromQuery(data, query.App)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -214,57 +235,219 @@ func (r flyAppResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 	}
 }
 
+// appKey is what the api knows the app by. Only id is set after an import, name is always set otherwise.
+func (d flyAppResourceData) appKey() string {
+	if !d.Id.Unknown && !d.Id.Null && d.Id.Value != "" {
+		return d.Id.Value
+	}
+	return d.Name.Value
+}
+
+// appDataFromQuery maps an app returned by the api onto resource data, prior supplies the configured org so a slug
+// doesn't drift to an ID, and the restart trigger which only lives in terraform
+func appDataFromQuery(prior flyAppResourceData, app graphql.GetFullAppApp) flyAppResourceData {
+	var regions []string
+	for _, s := range app.Autoscaling.Regions {
+		regions = append(regions, s.Code)
+	}
+
+	return flyAppResourceData{
+		Name:            types.String{Value: app.Name},
+		Id:              types.String{Value: app.Name},
+		Network:         types.String{Value: app.Network},
+		Org:             orgValue(prior.Org, app.Organization.Id, app.Organization.Slug),
+		PreferredRegion: types.String{Value: app.Autoscaling.PreferredRegion},
+		Regions:         stringList(regions),
+		Suspended:       types.Bool{Value: app.Status == "suspended"},
+		RestartTrigger:  prior.RestartTrigger,
+	}
+}
+
 func (r flyAppResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
 	var plan flyAppResourceData
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 
+	var state flyAppResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state flyAppResourceData
-	diags = resp.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-
 	tflog.Info(ctx, fmt.Sprintf("existing: %+v, new: %+v", state, plan))
 
+	appKey := state.appKey()
+
 	if !plan.Org.Unknown && plan.Org.Value != state.Org.Value {
-		resp.Diagnostics.AddError("Can't mutate org of existing app", "Can't swith org"+state.Org.Value+" to "+plan.Org.Value)
+		fromOrg, err := r.provider.orgId(state.Org)
+		if err != nil {
+			resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+			return
+		}
+		toOrg, err := r.provider.orgId(plan.Org)
+		if err != nil {
+			resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+			return
+		}
+		// Slug and ID of the same org compare unequal as strings, only move when they really differ
+		if fromOrg != toOrg {
+			_, err := graphql.MoveAppMutation(context.Background(), *r.provider.client, appKey, toOrg)
+			if err != nil {
+				resp.Diagnostics.AddError("Move app failed", err.Error())
+				return
+			}
+		}
 	}
-	if !plan.PreferredRegion.Unknown && plan.PreferredRegion.Value != state.PreferredRegion.Value {
-		resp.Diagnostics.AddError("Can't mutate PreferredRegion of existing app", "Can't switch preferred region "+state.PreferredRegion.Value+" to "+plan.PreferredRegion.Value)
+
+	if !plan.Regions.Unknown && !plan.Regions.Null && !plan.Regions.Equal(state.Regions) {
+		current, err := graphql.AppAutoscalingQuery(context.Background(), *r.provider.client, appKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Query regions failed", err.Error())
+			return
+		}
+		_, err = graphql.UpdateAutoScaleConfigMutation(context.Background(), *r.provider.client, appKey, regionUpdateInput(plan.Regions, current.App.Autoscaling.Regions), false)
+		if err != nil {
+			resp.Diagnostics.AddError("Update regions failed", err.Error())
+			return
+		}
 	}
-	if !plan.Name.Unknown && plan.Name.Value != state.Name.Value {
-		resp.Diagnostics.AddError("Can't mutate Name of existing app", "Can't switch name "+state.Name.Value+" to "+plan.Name.Value)
+
+	if !plan.Suspended.Unknown && plan.Suspended.Value != state.Suspended.Value {
+		if plan.Suspended.Value {
+			_, err := graphql.PauseAppMutation(context.Background(), *r.provider.client, appKey)
+			if err != nil {
+				resp.Diagnostics.AddError("Pause app failed", err.Error())
+				return
+			}
+		} else {
+			_, err := graphql.ResumeAppMutation(context.Background(), *r.provider.client, appKey)
+			if err != nil {
+				resp.Diagnostics.AddError("Resume app failed", err.Error())
+				return
+			}
+		}
 	}
-	if !plan.Network.Unknown && plan.Network.Value != state.Name.Value {
-		resp.Diagnostics.AddError("Can't mutate network of existing app", "Can't switch network"+state.Network.Value+" to "+plan.Network.Value)
+
+	// A suspended app has nothing running to restart, resuming it already starts fresh instances
+	suspended := state.Suspended.Value
+	if !plan.Suspended.Unknown {
+		suspended = plan.Suspended.Value
+	}
+	if !plan.RestartTrigger.Null && !plan.RestartTrigger.Equal(state.RestartTrigger) && !suspended {
+		_, err := graphql.RestartAppMutation(context.Background(), *r.provider.client, appKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Restart app failed", err.Error())
+			return
+		}
 	}
 
-	if len(plan.Regions) > 0 {
-		state.Regions = plan.Regions
+	query, err := graphql.GetFullApp(context.Background(), *r.provider.client, appKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Read after update failed", err.Error())
+		return
+	}
 
-		var rawRegions []graphql.AutoscaleRegionConfigInput
+	data := appDataFromQuery(plan, query.App)
 
-		for _, s := range plan.Regions {
-			rawRegions = append(rawRegions, graphql.AutoscaleRegionConfigInput{
-				Code: s.Value,
-			})
-		}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
 
-		_, err := graphql.UpdateAutoScaleConfigMutation(context.Background(), *r.provider.client, plan.Name.Value, rawRegions, true)
-		if err != nil {
-			resp.Diagnostics.AddError("Update regions failed", err.Error())
+func regionConfigInput(regions types.List) []graphql.AutoscaleRegionConfigInput {
+	var rawRegions []graphql.AutoscaleRegionConfigInput
+	for _, v := range regions.Elems {
+		rawRegions = append(rawRegions, graphql.AutoscaleRegionConfigInput{
+			Code: v.(types.String).Value,
+		})
+	}
+	return rawRegions
+}
+
+// regionUpdateInput moves the autoscale regions to regions without resetting the others, so min counts and weights
+// set through fly_app_autoscaling survive. Regions that are dropped are reset individually.
+func regionUpdateInput(regions types.List, current []graphql.AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig) []graphql.AutoscaleRegionConfigInput {
+	existing := map[string]graphql.AppAutoscalingQueryAppAutoscalingAutoscalingConfigRegionsAutoscaleRegionConfig{}
+	for _, region := range current {
+		existing[region.Code] = region
+	}
+
+	rawRegions := regionConfigInput(regions)
+	wanted := map[string]bool{}
+	for i, input := range rawRegions {
+		wanted[input.Code] = true
+		if region, ok := existing[input.Code]; ok {
+			minCount, weight := region.MinCount, region.Weight
+			rawRegions[i].MinCount = &minCount
+			rawRegions[i].Weight = &weight
+		}
+	}
+	reset := true
+	for _, region := range current {
+		if !wanted[region.Code] {
+			rawRegions = append(rawRegions, graphql.AutoscaleRegionConfigInput{Code: region.Code, Reset: &reset})
 		}
 	}
+	return rawRegions
+}
+
+// stringList builds a list attribute value, no values becomes a null list
+func stringList(values []string) types.List {
+	if len(values) == 0 {
+		return types.List{ElemType: types.StringType, Null: true}
+	}
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.String{Value: v})
+	}
+	return types.List{ElemType: types.StringType, Elems: elems}
+}
+
+// ModifyPlan warns about the side effects of moving an app to another organization before it happens
+func (r flyAppResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan flyAppResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 
-	resp.State.Set(ctx, state)
+	var state flyAppResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if plan.Org.Unknown || plan.Org.Null || plan.Org.Value == state.Org.Value {
+		return
+	}
+
+	fromOrg, err := r.provider.orgId(state.Org)
+	if err != nil {
+		return
+	}
+	toOrg, err := r.provider.orgId(plan.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+	if fromOrg == toOrg {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"App "+state.Name.Value+" will be moved to org "+plan.Org.Value,
+		"The app joins the private network of the new org. Apps and wireguard peers in "+state.Org.Value+" will no longer reach it over .internal addresses, and it will no longer reach them. "+
+			"IPs, certificates and volumes stay attached. The move shuts the app down and restarts it, expect some downtime.",
+	)
 }
 
 func (r flyAppResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -273,7 +456,7 @@ func (r flyAppResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequ
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
-	_, err := graphql.DeleteAppMutation(context.Background(), *r.provider.client, data.Name.Value)
+	_, err := graphql.DeleteAppMutation(context.Background(), *r.provider.client, data.appKey())
 	var errList gqlerror.List
 	if errors.As(err, &errList) {
 		for _, err := range errList {
@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/provider/modifiers"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyAppScaleResourceType{}
+var _ tfsdk.Resource = flyAppScaleResource{}
+var _ tfsdk.ResourceWithImportState = flyAppScaleResource{}
+var _ tfsdk.ResourceWithModifyPlan = flyAppScaleResource{}
+
+type flyAppScaleResourceType struct{}
+
+type flyAppScaleResource struct {
+	provider provider
+}
+
+type flyAppScaleResourceData struct {
+	Id           types.String `tfsdk:"id"`
+	App          types.String `tfsdk:"app"`
+	Group        types.String `tfsdk:"group"`
+	VmSize       types.String `tfsdk:"vm_size"`
+	MemoryMb     types.Int64  `tfsdk:"memory_mb"`
+	Count        types.Int64  `tfsdk:"count"`
+	MaxPerRegion types.Int64  `tfsdk:"max_per_region"`
+	RegionCounts types.Map    `tfsdk:"region_counts"`
+}
+
+func (t flyAppScaleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly app scale resource, the terraform equivalent of `flyctl scale` for apps managed by `fly_app`. Deleting it leaves the app scaled as it is.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "app/group",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"app": {
+				MarkdownDescription: "Name of app to scale",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"group": {
+				MarkdownDescription: "Process group to scale, defaults to app",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					modifiers.StringDefault("app"),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"vm_size": {
+				MarkdownDescription: "VM size name, e.g. shared-cpu-1x",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"memory_mb": {
+				MarkdownDescription: "Memory in MB, defaults to what the VM size comes with. Left out, it follows `vm_size` when that changes",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"count": {
+				MarkdownDescription: "Number of VMs to run",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"max_per_region": {
+				MarkdownDescription: "Maximum number of VMs to place in a single region",
+				Optional:            true,
+				Type:                types.Int64Type,
+			},
+			"region_counts": {
+				MarkdownDescription: "Number of VMs to run per region, keyed by region code. These are the configured placement counts of the app, not what is currently running. Regions not listed are left alone",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.Int64Type},
+			},
+		},
+	}, nil
+}
+
+func (t flyAppScaleResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyAppScaleResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyAppScaleResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyAppScaleResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags = r.apply(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppScaleResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyAppScaleResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.AppScaleQuery(context.Background(), *r.provider.client, data.App.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	data = appScaleDataFromQuery(data, query.App)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppScaleResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data flyAppScaleResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags = r.apply(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyAppScaleResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Scaling down to nothing is never what someone removing this resource wants, so just forget about it
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyAppScaleResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if parts[0] == "" || (len(parts) == 2 && parts[1] == "") {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected app or app/group, got %q", req.ID))
+		return
+	}
+	group := "app"
+	if len(parts) == 2 {
+		group = parts[1]
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), parts[0]+"/"+group)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("group"), group)...)
+}
+
+// apply scales the app to match plan and returns the resulting state. Only values that differ from what is running
+// are sent, setVmCount and scaleApp both trigger a new release.
+func (r flyAppScaleResource) apply(ctx context.Context, plan flyAppScaleResourceData) (flyAppScaleResourceData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	query, err := graphql.AppScaleQuery(context.Background(), *r.provider.client, plan.App.Value)
+	if err != nil {
+		diags.AddError("Query app failed", err.Error())
+		return plan, diags
+	}
+	current := appScaleDataFromQuery(plan, query.App)
+	group := plan.Group.Value
+
+	// Apps without a [processes] section only have the implicit app group, which the api doesn't accept by name
+	sizeGroup := ""
+	currentMaxPerRegion := int64(0)
+	for _, pg := range query.App.ProcessGroups {
+		if pg.Name == group {
+			sizeGroup = group
+			currentMaxPerRegion = int64(pg.MaxPerRegion)
+		}
+	}
+
+	sizeName := current.VmSize.Value
+	if !plan.VmSize.Unknown && !plan.VmSize.Null {
+		sizeName = plan.VmSize.Value
+	}
+	memoryMb := 0
+	if !plan.MemoryMb.Unknown && !plan.MemoryMb.Null {
+		memoryMb = int(plan.MemoryMb.Value)
+	}
+	if sizeName != current.VmSize.Value || (memoryMb != 0 && int64(memoryMb) != current.MemoryMb.Value) {
+		_, err := graphql.SetVmSizeMutation(context.Background(), *r.provider.client, plan.App.Value, sizeName, memoryMb, sizeGroup)
+		if err != nil {
+			diags.AddError("Set vm size failed", err.Error())
+			return plan, diags
+		}
+	}
+
+	count := current.Count.Value
+	if !plan.Count.Unknown && !plan.Count.Null {
+		count = plan.Count.Value
+	}
+	maxPerRegion := 0
+	if !plan.MaxPerRegion.Null && !plan.MaxPerRegion.Unknown {
+		maxPerRegion = int(plan.MaxPerRegion.Value)
+	}
+	if count != current.Count.Value || (maxPerRegion != 0 && int64(maxPerRegion) != currentMaxPerRegion) {
+		_, err := graphql.SetVmCountMutation(context.Background(), *r.provider.client, plan.App.Value, group, int(count), maxPerRegion)
+		if err != nil {
+			diags.AddError("Set vm count failed", err.Error())
+			return plan, diags
+		}
+	}
+
+	if !plan.RegionCounts.Null && !plan.RegionCounts.Unknown {
+		placed := placementByRegion(query.App)
+		var regions []graphql.ScaleRegionInput
+		for region, v := range plan.RegionCounts.Elems {
+			want := v.(types.Int64).Value
+			if delta := want - placed[region]; delta != 0 {
+				// scaleApp takes the change in count, not the desired count
+				regions = append(regions, graphql.ScaleRegionInput{Region: region, Count: int(delta)})
+			}
+		}
+		if len(regions) > 0 {
+			_, err := graphql.ScaleAppMutation(context.Background(), *r.provider.client, plan.App.Value, regions)
+			if err != nil {
+				diags.AddError("Scale regions failed", err.Error())
+				return plan, diags
+			}
+		}
+	}
+
+	query, err = graphql.AppScaleQuery(context.Background(), *r.provider.client, plan.App.Value)
+	if err != nil {
+		diags.AddError("Read after update failed", err.Error())
+		return plan, diags
+	}
+	return appScaleDataFromQuery(plan, query.App), diags
+}
+
+// ModifyPlan lets memory_mb follow a vm_size change when it isn't set in config, rather than sending the old size's
+// memory along with the new size
+func (r flyAppScaleResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state flyAppScaleResourceData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.MemoryMb.Null && (plan.VmSize.Unknown || plan.VmSize.Value != state.VmSize.Value) {
+		plan.MemoryMb = types.Int64{Unknown: true}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+}
+
+// placementByRegion returns the configured number of VMs per region. Allocations only catch up with these
+// asynchronously, so they'd make counts flap between plans.
+func placementByRegion(app graphql.AppScaleQueryApp) map[string]int64 {
+	counts := map[string]int64{}
+	for _, placement := range app.CurrentPlacement {
+		counts[placement.Region] = int64(placement.Count)
+	}
+	return counts
+}
+
+// appScaleDataFromQuery maps the scale of an app onto resource data. Optional only attributes are refreshed when
+// prior tracks them, so leaving them out of the config doesn't cause a diff.
+func appScaleDataFromQuery(prior flyAppScaleResourceData, app graphql.AppScaleQueryApp) flyAppScaleResourceData {
+	group := prior.Group.Value
+	if group == "" {
+		group = "app"
+	}
+
+	data := flyAppScaleResourceData{
+		Id:           types.String{Value: app.Name + "/" + group},
+		App:          types.String{Value: app.Name},
+		Group:        types.String{Value: group},
+		VmSize:       types.String{Value: app.VmSize.Name},
+		MemoryMb:     types.Int64{Value: int64(app.VmSize.MemoryMb)},
+		Count:        types.Int64{Value: 0},
+		MaxPerRegion: prior.MaxPerRegion,
+		RegionCounts: types.Map{ElemType: types.Int64Type, Null: true},
+	}
+
+	for _, pg := range app.ProcessGroups {
+		if pg.Name == group {
+			data.VmSize = types.String{Value: pg.VmSize.Name}
+			data.MemoryMb = types.Int64{Value: int64(pg.VmSize.MemoryMb)}
+			if !prior.MaxPerRegion.Null && !prior.MaxPerRegion.Unknown {
+				data.MaxPerRegion = types.Int64{Value: int64(pg.MaxPerRegion)}
+			}
+		}
+	}
+	for _, tg := range app.TaskGroupCounts {
+		if tg.Name == group {
+			data.Count = types.Int64{Value: int64(tg.Count)}
+		}
+	}
+
+	if !prior.RegionCounts.Null && !prior.RegionCounts.Unknown {
+		placed := placementByRegion(app)
+		elems := map[string]attr.Value{}
+		for region := range prior.RegionCounts.Elems {
+			elems[region] = types.Int64{Value: placed[region]}
+		}
+		data.RegionCounts = types.Map{ElemType: types.Int64Type, Elems: elems}
+	}
+
+	return data
+}
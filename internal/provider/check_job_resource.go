@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/provider/modifiers"
+	"dov.dev/fly/fly-provider/internal/provider/validators"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyCheckJobResourceType{}
+var _ tfsdk.Resource = flyCheckJobResource{}
+
+type flyCheckJobResourceType struct{}
+
+type flyCheckJobResource struct {
+	provider provider
+}
+
+type flyCheckJobResourceData struct {
+	Id         types.String `tfsdk:"id"`
+	Org        types.String `tfsdk:"org"`
+	Url        types.String `tfsdk:"url"`
+	Locations  types.Set    `tfsdk:"locations"`
+	Method     types.String `tfsdk:"method"`
+	Headers    types.Map    `tfsdk:"headers"`
+	RunTrigger types.Map    `tfsdk:"run_trigger"`
+	Schedule   types.String `tfsdk:"schedule"`
+}
+
+func (t flyCheckJobResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly check job resource, checks a URL from fly's check locations on a schedule. The api can't update or delete check jobs, so changes replace the job and destroying it only removes it from state.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of check job",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"org": {
+				MarkdownDescription: "Optional org slug or ID to operate upon, defaults to the provider org",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"url": {
+				MarkdownDescription: "URL to check",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"locations": {
+				MarkdownDescription: "Locations to check from, see the fly_check_locations data source",
+				Required:            true,
+				Type:                types.SetType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"method": {
+				MarkdownDescription: "HTTP method, get or head. Defaults to get",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					modifiers.StringDefault("get"),
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringOneOf("get", "head"),
+				},
+			},
+			"headers": {
+				MarkdownDescription: "Headers to send",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"run_trigger": {
+				MarkdownDescription: "Arbitrary values that run the check right away when changed, e.g. the current release",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+			},
+			"schedule": {
+				MarkdownDescription: "Schedule the check runs on",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyCheckJobResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyCheckJobResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyCheckJobResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyCheckJobResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not detect default organization", err.Error())
+		return
+	}
+
+	var headers []string
+	for name, value := range data.Headers.Elems {
+		headers = append(headers, name+": "+value.(types.String).Value)
+	}
+	sort.Strings(headers)
+
+	q, err := graphql.CreateCheckJobMutation(context.Background(), *r.provider.client, orgId, data.Url.Value, setStrings(data.Locations), graphql.CheckHTTPVerb(strings.ToUpper(data.Method.Value)), headers)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create check job", err.Error())
+		return
+	}
+
+	if data.Org.Unknown || data.Org.Null {
+		data.Org = types.String{Value: orgId}
+	}
+	data = checkJobData(data, q.CreateCheckJob.CheckJob.CheckJobFragment)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyCheckJobResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyCheckJobResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.CheckJobQuery(context.Background(), *r.provider.client, data.Id.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+	job, ok := query.Node.(*graphql.CheckJobQueryNodeCheckJob)
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = checkJobData(data, job.CheckJobFragment)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyCheckJobResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data flyCheckJobResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	var state flyCheckJobResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Everything else replaces the job, so only the trigger can get here
+	if !data.RunTrigger.Null && !data.RunTrigger.Equal(state.RunTrigger) {
+		_, err := graphql.CreateCheckJobRunMutation(context.Background(), *r.provider.client, state.Id.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to run check job", err.Error())
+			return
+		}
+	}
+
+	data.Id = state.Id
+	data.Schedule = state.Schedule
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyCheckJobResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyCheckJobResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.AddWarning("Check job not deleted", fmt.Sprintf("The fly api can't delete check jobs, %s keeps checking %s", data.Id.Value, data.Url.Value))
+	resp.State.RemoveResource(ctx)
+}
+
+// checkJobData maps a check job returned by the api onto data, headers come back as "Name: value" strings
+func checkJobData(data flyCheckJobResourceData, job graphql.CheckJobFragment) flyCheckJobResourceData {
+	data.Id = types.String{Value: job.Id}
+	data.Url = types.String{Value: job.Url}
+	data.Schedule = types.String{Value: job.Schedule}
+	data.Method = types.String{Value: strings.ToLower(string(job.HttpOptions.Verb))}
+
+	var locations []string
+	for _, location := range job.Locations.Nodes {
+		locations = append(locations, location.Name)
+	}
+	data.Locations = stringSet(locations)
+
+	if len(job.HttpOptions.Headers) > 0 || !data.Headers.Null {
+		headers := map[string]attr.Value{}
+		for _, header := range job.HttpOptions.Headers {
+			parts := strings.SplitN(header, ":", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			headers[parts[0]] = types.String{Value: strings.TrimSpace(parts[1])}
+		}
+		data.Headers = types.Map{ElemType: types.StringType, Elems: headers}
+	}
+	return data
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = checkJobRunDataSourceType{}
+var _ tfsdk.DataSource = checkJobRunDataSource{}
+
+type checkJobRunDataSourceType struct{}
+
+// Matches getSchema
+type checkJobRunDataSourceOutput struct {
+	Id          types.String                 `tfsdk:"id"`
+	CheckJob    types.String                 `tfsdk:"check_job"`
+	State       types.String                 `tfsdk:"state"`
+	Url         types.String                 `tfsdk:"url"`
+	Tests       []types.String               `tfsdk:"tests"`
+	CreatedAt   types.String                 `tfsdk:"created_at"`
+	CompletedAt types.String                 `tfsdk:"completed_at"`
+	Passing     types.Bool                   `tfsdk:"passing"`
+	Responses   []checkJobResponseOutputData `tfsdk:"responses"`
+}
+
+type checkJobResponseOutputData struct {
+	Location   types.String `tfsdk:"location"`
+	StatusCode types.Int64  `tfsdk:"status_code"`
+	ResolvedIp types.String `tfsdk:"resolved_ip"`
+}
+
+// Runs are fetched in a small batch and the newest picked, the api doesn't document their order
+const checkJobRunsWindow = 5
+
+func (t checkJobRunDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Retrieve the latest run of a check job. Pair `passing` with a postcondition to stop a plan while a check is failing",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of run",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"check_job": {
+				MarkdownDescription: "ID of check job",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"state": {
+				MarkdownDescription: "State of run",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"url": {
+				MarkdownDescription: "URL that was checked",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"tests": {
+				MarkdownDescription: "Tests of run",
+				Computed:            true,
+				Type:                types.ListType{ElemType: types.StringType},
+			},
+			"created_at": {
+				MarkdownDescription: "When the run started, RFC3339 formatted",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"completed_at": {
+				MarkdownDescription: "When the run completed, RFC3339 formatted. Empty while running",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"passing": {
+				MarkdownDescription: "Whether every location got a response with a status below 400",
+				Computed:            true,
+				Type:                types.BoolType,
+			},
+			"responses": {
+				MarkdownDescription: "Responses per location",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"location": {
+						MarkdownDescription: "Location the check ran from",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"status_code": {
+						MarkdownDescription: "HTTP status of the response",
+						Computed:            true,
+						Type:                types.Int64Type,
+					},
+					"resolved_ip": {
+						MarkdownDescription: "Address the URL resolved to",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t checkJobRunDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return checkJobRunDataSource{
+		provider: provider,
+	}, diags
+}
+
+func (d checkJobRunDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data checkJobRunDataSourceOutput
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.CheckJobRunsQuery(context.Background(), *d.provider.client, data.CheckJob.Value, checkJobRunsWindow)
+	if err != nil {
+		resp.Diagnostics.AddError("Query failed", err.Error())
+		return
+	}
+	job, ok := query.Node.(*graphql.CheckJobRunsQueryNodeCheckJob)
+	if !ok {
+		resp.Diagnostics.AddError("Check job not found", "No check job with ID "+data.CheckJob.Value)
+		return
+	}
+	if len(job.Runs.Nodes) == 0 {
+		resp.Diagnostics.AddError("Check job has not run yet", "No runs for check job "+data.CheckJob.Value)
+		return
+	}
+
+	latest := job.Runs.Nodes[0]
+	for _, run := range job.Runs.Nodes[1:] {
+		if run.CreatedAt.After(latest.CreatedAt) {
+			latest = run
+		}
+	}
+
+	data.Id = types.String{Value: latest.Id}
+	data.State = types.String{Value: latest.State}
+	data.Url = types.String{Value: latest.Url}
+	data.CreatedAt = types.String{Value: latest.CreatedAt.Format(time.RFC3339)}
+	data.CompletedAt = types.String{Value: ""}
+	if !latest.CompletedAt.IsZero() {
+		data.CompletedAt = types.String{Value: latest.CompletedAt.Format(time.RFC3339)}
+	}
+	data.Tests = []types.String{}
+	for _, test := range latest.Tests {
+		data.Tests = append(data.Tests, types.String{Value: test})
+	}
+
+	passing := len(latest.HttpResponses.Nodes) > 0
+	data.Responses = []checkJobResponseOutputData{}
+	for _, response := range latest.HttpResponses.Nodes {
+		if response.StatusCode >= 400 {
+			passing = false
+		}
+		data.Responses = append(data.Responses, checkJobResponseOutputData{
+			Location:   types.String{Value: response.Location.Name},
+			StatusCode: types.Int64{Value: int64(response.StatusCode)},
+			ResolvedIp: types.String{Value: response.ResolvedIp},
+		})
+	}
+	data.Passing = types.Bool{Value: passing}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
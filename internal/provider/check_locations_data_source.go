@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = checkLocationsDataSourceType{}
+var _ tfsdk.DataSource = checkLocationsDataSource{}
+
+type checkLocationsDataSourceType struct{}
+
+// Matches getSchema
+type checkLocationsDataSourceOutput struct {
+	Id        types.String              `tfsdk:"id"`
+	Names     []types.String            `tfsdk:"names"`
+	Locations []checkLocationOutputData `tfsdk:"locations"`
+}
+
+type checkLocationOutputData struct {
+	Name     types.String `tfsdk:"name"`
+	Title    types.String `tfsdk:"title"`
+	Locality types.String `tfsdk:"locality"`
+	State    types.String `tfsdk:"state"`
+	Country  types.String `tfsdk:"country"`
+}
+
+func (t checkLocationsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Retrieve the locations check jobs can run from",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Always check_locations",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"names": {
+				MarkdownDescription: "Names of all locations, usable as fly_check_job locations",
+				Computed:            true,
+				Type:                types.ListType{ElemType: types.StringType},
+			},
+			"locations": {
+				MarkdownDescription: "Locations",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"name": {
+						MarkdownDescription: "Name of location",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"title": {
+						MarkdownDescription: "Human readable name of location",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"locality": {
+						MarkdownDescription: "City of location",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"state": {
+						MarkdownDescription: "State of location, if any",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"country": {
+						MarkdownDescription: "Country of location",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t checkLocationsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return checkLocationsDataSource{
+		provider: provider,
+	}, diags
+}
+
+func (d checkLocationsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	query, err := graphql.CheckLocationsQuery(context.Background(), *d.provider.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Query failed", err.Error())
+		return
+	}
+
+	data := checkLocationsDataSourceOutput{
+		Id:        types.String{Value: "check_locations"},
+		Names:     []types.String{},
+		Locations: []checkLocationOutputData{},
+	}
+	for _, location := range query.CheckLocations {
+		data.Names = append(data.Names, types.String{Value: location.Name})
+		data.Locations = append(data.Locations, checkLocationOutputData{
+			Name:     types.String{Value: location.Name},
+			Title:    types.String{Value: location.Title},
+			Locality: types.String{Value: location.Locality},
+			State:    types.String{Value: location.State},
+			Country:  types.String{Value: location.Country},
+		})
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
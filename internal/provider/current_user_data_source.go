@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = currentUserDataSourceType{}
+var _ tfsdk.DataSource = currentUserDataSource{}
+
+type currentUserDataSourceType struct{}
+
+// Matches getSchema
+type currentUserDataSourceOutput struct {
+	Id            types.String                  `tfsdk:"id"`
+	Email         types.String                  `tfsdk:"email"`
+	Name          types.String                  `tfsdk:"name"`
+	Organizations []currentUserOrganizationData `tfsdk:"organizations"`
+}
+
+type currentUserOrganizationData struct {
+	Id   types.String `tfsdk:"id"`
+	Slug types.String `tfsdk:"slug"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (t currentUserDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Retrieve info about the user that owns the configured token",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of user",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"email": {
+				MarkdownDescription: "Email address of user",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"name": {
+				MarkdownDescription: "Display name of user",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"organizations": {
+				MarkdownDescription: "Organizations the user belongs to",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "ID of organization",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"slug": {
+						MarkdownDescription: "Slug of organization",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"name": {
+						MarkdownDescription: "Name of organization",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t currentUserDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return currentUserDataSource{
+		provider: provider,
+	}, diags
+}
+
+func (d currentUserDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	// Identity is resolved once in Configure, so there is nothing to query here
+	data := currentUserDataSourceOutput{
+		Id:            types.String{Value: d.provider.user.Id},
+		Email:         types.String{Value: d.provider.user.Email},
+		Name:          types.String{Value: d.provider.user.Name},
+		Organizations: []currentUserOrganizationData{},
+	}
+
+	for _, o := range d.provider.orgs {
+		data.Organizations = append(data.Organizations, currentUserOrganizationData{
+			Id:   types.String{Value: o.Id},
+			Slug: types.String{Value: o.Slug},
+			Name: types.String{Value: o.Name},
+		})
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/provider/validators"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"time"
+)
+
+var _ tfsdk.ResourceType = flyDeploymentResourceType{}
+var _ tfsdk.Resource = flyDeploymentResource{}
+
+type flyDeploymentResourceType struct{}
+
+type flyDeploymentResource struct {
+	provider provider
+}
+
+type flyDeploymentResourceData struct {
+	Id             types.String `tfsdk:"id"`
+	App            types.String `tfsdk:"app"`
+	Image          types.String `tfsdk:"image"`
+	Definition     types.String `tfsdk:"definition"`
+	Strategy       types.String `tfsdk:"strategy"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+	RollbackTo     types.Int64  `tfsdk:"rollback_to"`
+	ReleaseId      types.String `tfsdk:"release_id"`
+	ReleaseVersion types.Int64  `tfsdk:"release_version"`
+	Status         types.String `tfsdk:"status"`
+}
+
+const defaultDeploymentTimeout = 600
+
+func (t flyDeploymentResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly deployment resource, deploys an already built image to an app and waits for the deployment to finish. Changing the image or definition deploys again, destroying it leaves the app running. Set rollback_to to revert to an earlier release.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of the release",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"app": {
+				MarkdownDescription: "Name of app to deploy to",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"image": {
+				MarkdownDescription: "Image to deploy, e.g. registry.fly.io/myapp:v2",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"definition": {
+				MarkdownDescription: "App definition as JSON, the same shape as fly.toml. Services and checks go here",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"strategy": {
+				MarkdownDescription: "Deployment strategy, one of immediate, simple, rolling, canary or bluegreen. Defaults to the app's strategy",
+				Optional:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringOneOf("immediate", "simple", "rolling", "canary", "bluegreen"),
+				},
+			},
+			"timeout": {
+				MarkdownDescription: "Seconds to wait for the deployment to finish. Defaults to 600",
+				Optional:            true,
+				Type:                types.Int64Type,
+			},
+			"rollback_to": {
+				MarkdownDescription: "Release version to roll back to. While set the image and definition of that release are deployed instead, remove it to roll forward again",
+				Optional:            true,
+				Type:                types.Int64Type,
+			},
+			"release_id": {
+				MarkdownDescription: "ID of the release created by the deployment",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"release_version": {
+				MarkdownDescription: "Version of the release created by the deployment",
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"status": {
+				MarkdownDescription: "Final status of the deployment",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t flyDeploymentResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyDeploymentResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyDeploymentResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyDeploymentResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags = r.deploy(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	// The release exists even when the deployment failed, keep it in state so the next apply retries
+	if !data.ReleaseId.Unknown {
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (r flyDeploymentResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyDeploymentResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.CurrentReleaseQuery(context.Background(), *r.provider.client, data.App.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	// Someone released since. Only a different image is drift, surface it so the plan deploys ours again. Config
+	// changes and restarts release the same image, which the api reports in registry/digest form.
+	release := query.App.CurrentRelease
+	if release.Id != "" && int64(release.Version) != data.ReleaseVersion.Value {
+		if release.ImageRef != "" && !sameImage(data.Image.Value, release.Image) {
+			data.Image = types.String{Value: release.ImageRef}
+		}
+		data.ReleaseId = types.String{Value: release.Id}
+		data.ReleaseVersion = types.Int64{Value: int64(release.Version)}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyDeploymentResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data flyDeploymentResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	var state flyDeploymentResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timeout changed, nothing to deploy
+	if data.Image.Equal(state.Image) && data.Definition.Equal(state.Definition) && data.Strategy.Equal(state.Strategy) && data.RollbackTo.Equal(state.RollbackTo) {
+		data.Id = state.Id
+		data.ReleaseId = state.ReleaseId
+		data.ReleaseVersion = state.ReleaseVersion
+		data.Status = state.Status
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data, diags = r.deploy(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if !data.ReleaseId.Unknown {
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (r flyDeploymentResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Releases can't be deleted, and tearing down the app is fly_app's job
+	resp.State.RemoveResource(ctx)
+}
+
+// deploy releases plan and waits for the deployment to settle. The returned data has the release filled in as soon as
+// one was created, even when waiting fails.
+func (r flyDeploymentResource) deploy(ctx context.Context, data flyDeploymentResourceData) (flyDeploymentResourceData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	image := data.Image.Value
+	var definition interface{}
+	if !data.RollbackTo.Null {
+		query, err := graphql.ReleaseQuery(context.Background(), *r.provider.client, data.App.Value, int(data.RollbackTo.Value))
+		if err != nil {
+			diags.AddError("Query release to roll back to failed", err.Error())
+			return data, diags
+		}
+		if query.App.Release.Id == "" || query.App.Release.ImageRef == "" {
+			diags.AddError("Release not found", fmt.Sprintf("App %s has no release v%d with an image to roll back to", data.App.Value, data.RollbackTo.Value))
+			return data, diags
+		}
+		image = query.App.Release.ImageRef
+		definition = query.App.Release.Config.Definition
+	} else if !data.Definition.Null && data.Definition.Value != "" {
+		if err := json.Unmarshal([]byte(data.Definition.Value), &definition); err != nil {
+			diags.AddError("Invalid definition", err.Error())
+			return data, diags
+		}
+	}
+
+	var strategy graphql.DeploymentStrategy
+	if !data.Strategy.Null {
+		strategy = graphql.DeploymentStrategy(strings.ToUpper(data.Strategy.Value))
+	}
+
+	deployed, err := graphql.DeployImageMutation(context.Background(), *r.provider.client, data.App.Value, image, definition, strategy)
+	if err != nil {
+		diags.AddError("Deploy failed", err.Error())
+		return data, diags
+	}
+	release := deployed.DeployImage.Release
+
+	data.Id = types.String{Value: release.Id}
+	data.ReleaseId = types.String{Value: release.Id}
+	data.ReleaseVersion = types.Int64{Value: int64(release.Version)}
+	data.Status = types.String{Value: "pending"}
+
+	timeout := time.Duration(defaultDeploymentTimeout) * time.Second
+	if !data.Timeout.Null && !data.Timeout.Unknown {
+		timeout = time.Duration(data.Timeout.Value) * time.Second
+	}
+
+	status, err := r.provider.waitForDeployment(ctx, data.App.Value, release.EvaluationId, timeout)
+	if status != "" {
+		data.Status = types.String{Value: status}
+	}
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Deployment of release v%d failed", release.Version), err.Error())
+	}
+	return data, diags
+}
+
+// waitForDeployment polls the deployment started by evaluationId until it is no longer in progress
+func (p provider) waitForDeployment(ctx context.Context, app string, evaluationId string, timeout time.Duration) (string, error) {
+	deadline := time.Now().Add(timeout)
+	status := ""
+	for {
+		query, err := graphql.DeploymentStatusQuery(context.Background(), *p.client, app, evaluationId)
+		if err != nil {
+			return status, err
+		}
+		deployment := query.App.DeploymentStatus
+		status = deployment.Status
+		tflog.Debug(ctx, fmt.Sprintf("deployment %s: %s, %d/%d healthy", deployment.Id, deployment.Status, deployment.HealthyCount, deployment.DesiredCount))
+
+		if !deployment.InProgress {
+			if deployment.Successful {
+				return status, nil
+			}
+			return status, fmt.Errorf("%s: %s", deployment.Status, deployment.Description)
+		}
+		if time.Now().After(deadline) {
+			return status, fmt.Errorf("timed out after %s waiting for the deployment, last status %s: %s", timeout, deployment.Status, deployment.Description)
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+// imageName is an image reference split into its parts, with docker hub defaults filled in
+type imageName struct {
+	registry   string
+	repository string
+	tag        string
+	digest     string
+}
+
+// dockerHubRegistries all serve docker hub images, fly pulls them through its own mirror
+var dockerHubRegistries = map[string]bool{
+	"":                         true,
+	"docker.io":                true,
+	"index.docker.io":          true,
+	"registry-1.docker.io":     true,
+	"docker-hub-mirror.fly.io": true,
+}
+
+func parseImageName(ref string) imageName {
+	var name imageName
+	if i := strings.Index(ref, "@"); i >= 0 {
+		ref, name.digest = ref[:i], ref[i+1:]
+	}
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref, name.tag = ref[:i], ref[i+1:]
+	}
+	name.registry = "docker.io"
+	if parts := strings.SplitN(ref, "/", 2); len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		name.registry, ref = parts[0], parts[1]
+	}
+	return normalizeImageName(name.registry, ref, name.tag, name.digest)
+}
+
+func normalizeImageName(registry string, repository string, tag string, digest string) imageName {
+	if dockerHubRegistries[registry] {
+		registry = "docker.io"
+		if !strings.Contains(repository, "/") {
+			repository = "library/" + repository
+		}
+	}
+	if tag == "" && digest == "" {
+		tag = "latest"
+	}
+	return imageName{registry: registry, repository: repository, tag: tag, digest: digest}
+}
+
+// sameImage reports whether the configured image could be what the release runs. A tag can't be matched against a
+// digest, so only parts known on both sides are compared.
+func sameImage(configured string, released graphql.CurrentReleaseQueryAppCurrentReleaseImage) bool {
+	if released.Repository == "" {
+		return true
+	}
+	want := parseImageName(configured)
+	got := normalizeImageName(released.Registry, released.Repository, released.Tag, released.Digest)
+	if want.registry != got.registry || want.repository != got.repository {
+		return false
+	}
+	if want.digest != "" && got.digest != "" {
+		return want.digest == got.digest
+	}
+	if want.tag != "" && released.Tag != "" {
+		return want.tag == got.tag
+	}
+	return true
+}
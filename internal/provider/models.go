@@ -12,3 +12,30 @@ type ipDataSource struct {
 type volumeDataSource struct {
 	provider provider
 }
+type currentUserDataSource struct {
+	provider provider
+}
+type organizationDataSource struct {
+	provider provider
+}
+type privateDnsDataSource struct {
+	provider provider
+}
+type privateHttpCheckDataSource struct {
+	provider provider
+}
+type appReleaseDataSource struct {
+	provider provider
+}
+type appReleasesDataSource struct {
+	provider provider
+}
+type postgresAttachmentsDataSource struct {
+	provider provider
+}
+type checkLocationsDataSource struct {
+	provider provider
+}
+type checkJobRunDataSource struct {
+	provider provider
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = organizationDataSourceType{}
+var _ tfsdk.DataSource = organizationDataSource{}
+
+type organizationDataSourceType struct{}
+
+// Matches getSchema
+type organizationDataSourceOutput struct {
+	Slug          types.String                   `tfsdk:"slug"`
+	Id            types.String                   `tfsdk:"id"`
+	Name          types.String                   `tfsdk:"name"`
+	Type          types.String                   `tfsdk:"type"`
+	BillingStatus types.String                   `tfsdk:"billing_status"`
+	Members       []organizationMemberOutputData `tfsdk:"members"`
+}
+
+type organizationMemberOutputData struct {
+	Id       types.String `tfsdk:"id"`
+	Email    types.String `tfsdk:"email"`
+	Name     types.String `tfsdk:"name"`
+	Role     types.String `tfsdk:"role"`
+	JoinedAt types.String `tfsdk:"joined_at"`
+}
+
+func (t organizationDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Retrieve info about an organization",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"slug": {
+				MarkdownDescription: "Slug of organization",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"id": {
+				MarkdownDescription: "ID of organization",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"name": {
+				MarkdownDescription: "Name of organization",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"type": {
+				MarkdownDescription: "PERSONAL or SHARED",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"billing_status": {
+				MarkdownDescription: "CURRENT, SOURCE_REQUIRED or PAST_DUE",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"members": {
+				MarkdownDescription: "Members of organization",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "ID of user",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"email": {
+						MarkdownDescription: "Email of user",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"name": {
+						MarkdownDescription: "Name of user",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"role": {
+						MarkdownDescription: "admin or member",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"joined_at": {
+						MarkdownDescription: "When the user joined, RFC3339 formatted",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t organizationDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return organizationDataSource{
+		provider: provider,
+	}, diags
+}
+
+func (d organizationDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data organizationDataSourceOutput
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.GetFullOrganization(context.Background(), *d.provider.client, "", data.Slug.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Query failed", err.Error())
+		return
+	}
+	if query.Organization.Id == "" {
+		resp.Diagnostics.AddError("Organization not found", "No organization with slug "+data.Slug.Value)
+		return
+	}
+
+	data = organizationDataSourceOutput{
+		Slug:          types.String{Value: query.Organization.Slug},
+		Id:            types.String{Value: query.Organization.Id},
+		Name:          types.String{Value: query.Organization.Name},
+		Type:          types.String{Value: string(query.Organization.Type)},
+		BillingStatus: types.String{Value: string(query.Organization.BillingStatus)},
+		Members:       []organizationMemberOutputData{},
+	}
+
+	members, err := utils.ListOrgMembers(*d.provider.client, query.Organization.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Query members failed", err.Error())
+		return
+	}
+	for _, edge := range members {
+		data.Members = append(data.Members, organizationMemberOutputData{
+			Id:       types.String{Value: edge.Node.Id},
+			Email:    types.String{Value: edge.Node.Email},
+			Name:     types.String{Value: edge.Node.Name},
+			Role:     types.String{Value: strings.ToLower(string(edge.Role))},
+			JoinedAt: types.String{Value: edge.JoinedAt.Format(time.RFC3339)},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyOrganizationInvitationResourceType{}
+var _ tfsdk.Resource = flyOrganizationInvitationResource{}
+var _ tfsdk.ResourceWithImportState = flyOrganizationInvitationResource{}
+
+type flyOrganizationInvitationResourceType struct{}
+
+type flyOrganizationInvitationResource struct {
+	provider provider
+}
+
+type flyOrganizationInvitationResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Org      types.String `tfsdk:"org"`
+	Email    types.String `tfsdk:"email"`
+	Redeemed types.Bool   `tfsdk:"redeemed"`
+}
+
+func (t flyOrganizationInvitationResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly organization invitation resource",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of invitation",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"org": {
+				MarkdownDescription: "Org slug or ID",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"email": {
+				MarkdownDescription: "Email to send the invitation to",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"redeemed": {
+				MarkdownDescription: "Whether the invitation has been accepted",
+				Computed:            true,
+				Type:                types.BoolType,
+			},
+		},
+	}, nil
+}
+
+func (t flyOrganizationInvitationResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyOrganizationInvitationResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyOrganizationInvitationResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyOrganizationInvitationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	q, err := graphql.CreateOrganizationInvitation(context.Background(), *r.provider.client, orgId, data.Email.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create invitation", err.Error())
+		return
+	}
+
+	data = flyOrganizationInvitationResourceData{
+		Id:       types.String{Value: q.CreateOrganizationInvitation.Invitation.Id},
+		Org:      data.Org,
+		Email:    data.Email,
+		Redeemed: types.Bool{Value: q.CreateOrganizationInvitation.Invitation.Redeemed},
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyOrganizationInvitationResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyOrganizationInvitationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	invitations, err := utils.ListOrgInvitations(*r.provider.client, orgId)
+	if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	found := false
+	for _, invitation := range invitations {
+		if invitation.Id == data.Id.Value {
+			data.Email = types.String{Value: invitation.Email}
+			data.Redeemed = types.Bool{Value: invitation.Redeemed}
+			found = true
+			break
+		}
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyOrganizationInvitationResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError("The fly api does not allow updating invitations once created", "Try deleting and then recreating the invitation with new options")
+	return
+}
+
+func (r flyOrganizationInvitationResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyOrganizationInvitationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Redeemed invitations have already turned into memberships, which are managed by fly_organization_member
+	if !data.Redeemed.Value {
+		_, err := graphql.DeleteOrganizationInvitation(context.Background(), *r.provider.client, data.Id.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Delete invitation failed", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState expects an ID in the form org/invitation_id
+func (r flyOrganizationInvitationResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected org/invitation_id, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("org"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), parts[1])...)
+}
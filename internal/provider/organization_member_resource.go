@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/provider/validators"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyOrganizationMemberResourceType{}
+var _ tfsdk.Resource = flyOrganizationMemberResource{}
+var _ tfsdk.ResourceWithImportState = flyOrganizationMemberResource{}
+
+type flyOrganizationMemberResourceType struct{}
+
+type flyOrganizationMemberResource struct {
+	provider provider
+}
+
+type flyOrganizationMemberResourceData struct {
+	Id     types.String `tfsdk:"id"`
+	Org    types.String `tfsdk:"org"`
+	Email  types.String `tfsdk:"email"`
+	UserId types.String `tfsdk:"user_id"`
+	Role   types.String `tfsdk:"role"`
+}
+
+func (t flyOrganizationMemberResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly organization membership resource. The user must already have accepted an invitation to the organization.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of membership, in the form org/email",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"org": {
+				MarkdownDescription: "Org slug or ID",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"email": {
+				MarkdownDescription: "Email of member",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"user_id": {
+				MarkdownDescription: "ID of member",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"role": {
+				MarkdownDescription: "admin or member",
+				Required:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringOneOf("admin", "member"),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyOrganizationMemberResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyOrganizationMemberResource{
+		provider: provider,
+	}, diags
+}
+
+// findOrgMember looks up a member of org by email, returning nil if they haven't joined
+func findOrgMember(members []graphql.OrgMemberFragment, email string) *graphql.OrgMemberFragment {
+	for _, member := range members {
+		if strings.EqualFold(member.Node.Email, email) {
+			return &member
+		}
+	}
+	return nil
+}
+
+func (r flyOrganizationMemberResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyOrganizationMemberResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	members, err := utils.ListOrgMembers(*r.provider.client, orgId)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query organization members", err.Error())
+		return
+	}
+
+	member := findOrgMember(members, data.Email.Value)
+	if member == nil {
+		resp.Diagnostics.AddError("User is not a member of organization", fmt.Sprintf("%s has not joined %s yet, invite them with fly_organization_invitation first", data.Email.Value, data.Org.Value))
+		return
+	}
+
+	_, err = graphql.UpdateOrganizationMembership(context.Background(), *r.provider.client, orgId, member.Node.Id, graphql.OrganizationMemberRole(strings.ToUpper(data.Role.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set member role", err.Error())
+		return
+	}
+
+	data = flyOrganizationMemberResourceData{
+		Id:     types.String{Value: data.Org.Value + "/" + data.Email.Value},
+		Org:    data.Org,
+		Email:  data.Email,
+		UserId: types.String{Value: member.Node.Id},
+		Role:   data.Role,
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyOrganizationMemberResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyOrganizationMemberResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	members, err := utils.ListOrgMembers(*r.provider.client, orgId)
+	if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	member := findOrgMember(members, data.Email.Value)
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	role := data.Role
+	if !strings.EqualFold(role.Value, string(member.Role)) {
+		role = types.String{Value: strings.ToLower(string(member.Role))}
+	}
+
+	data = flyOrganizationMemberResourceData{
+		Id:     types.String{Value: data.Org.Value + "/" + data.Email.Value},
+		Org:    data.Org,
+		Email:  data.Email,
+		UserId: types.String{Value: member.Node.Id},
+		Role:   role,
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyOrganizationMemberResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan flyOrganizationMemberResourceData
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(plan.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	_, err = graphql.UpdateOrganizationMembership(context.Background(), *r.provider.client, orgId, plan.UserId.Value, graphql.OrganizationMemberRole(strings.ToUpper(plan.Role.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set member role", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyOrganizationMemberResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyOrganizationMemberResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	_, err = graphql.DeleteOrganizationMembership(context.Background(), *r.provider.client, orgId, data.UserId.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete membership failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState expects an ID in the form org/email
+func (r flyOrganizationMemberResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected org/email, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("org"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("email"), parts[1])...)
+}
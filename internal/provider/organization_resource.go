@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ tfsdk.ResourceType = flyOrganizationResourceType{}
+var _ tfsdk.Resource = flyOrganizationResource{}
+var _ tfsdk.ResourceWithImportState = flyOrganizationResource{}
+
+type flyOrganizationResourceType struct{}
+
+type flyOrganizationResource struct {
+	provider provider
+}
+
+type flyOrganizationResourceData struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Slug types.String `tfsdk:"slug"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (t flyOrganizationResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly organization resource",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of organization",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"name": {
+				MarkdownDescription: "Name of organization",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"slug": {
+				MarkdownDescription: "Unique slug of organization, derived from the name",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"type": {
+				MarkdownDescription: "PERSONAL or SHARED",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyOrganizationResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyOrganizationResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyOrganizationResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyOrganizationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q, err := graphql.CreateOrganization(context.Background(), *r.provider.client, data.Name.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization", err.Error())
+		return
+	}
+
+	data = flyOrganizationResourceData{
+		Id:   types.String{Value: q.CreateOrganization.Organization.Id},
+		Name: types.String{Value: q.CreateOrganization.Organization.Name},
+		Slug: types.String{Value: q.CreateOrganization.Organization.Slug},
+		Type: types.String{Value: string(q.CreateOrganization.Organization.Type)},
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyOrganizationResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyOrganizationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.GetFullOrganization(context.Background(), *r.provider.client, data.Id.Value, "")
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	if query.Organization.Id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = flyOrganizationResourceData{
+		Id:   types.String{Value: query.Organization.Id},
+		Name: types.String{Value: query.Organization.Name},
+		Slug: types.String{Value: query.Organization.Slug},
+		Type: types.String{Value: string(query.Organization.Type)},
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyOrganizationResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError("The fly api does not allow updating organizations once created", "Try deleting and then recreating the organization with new options")
+	return
+}
+
+func (r flyOrganizationResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyOrganizationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	_, err := graphql.DeleteOrganization(context.Background(), *r.provider.client, data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete organization failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState accepts either the organization ID or its slug
+func (r flyOrganizationResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	org, err := utils.ResolveOrg(*r.provider.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not find organization to import", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), org.Id)...)
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyPagerdutyHandlerResourceType{}
+var _ tfsdk.Resource = flyPagerdutyHandlerResource{}
+var _ tfsdk.ResourceWithImportState = flyPagerdutyHandlerResource{}
+
+type flyPagerdutyHandlerResourceType struct{}
+
+type flyPagerdutyHandlerResource struct {
+	provider provider
+}
+
+type flyPagerdutyHandlerResourceData struct {
+	Id        types.String `tfsdk:"id"`
+	Org       types.String `tfsdk:"org"`
+	Name      types.String `tfsdk:"name"`
+	Token     types.String `tfsdk:"token"`
+	StatusMap types.Map    `tfsdk:"status_map"`
+}
+
+func (t flyPagerdutyHandlerResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly pagerduty handler resource, sends health check alerts of an organization to pagerduty. The api never hands settings back, so only removal is detected.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of handler, same as name",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"org": {
+				MarkdownDescription: "Optional org slug or ID to operate upon, defaults to the provider org",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Name of handler",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"token": {
+				MarkdownDescription: "PagerDuty API token",
+				Required:            true,
+				Sensitive:           true,
+				Type:                types.StringType,
+			},
+			"status_map": {
+				MarkdownDescription: "Map of check statuses to PagerDuty severities, e.g. `{ critical = \"error\" }`",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+			},
+		},
+	}, nil
+}
+
+func (t flyPagerdutyHandlerResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyPagerdutyHandlerResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyPagerdutyHandlerResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyPagerdutyHandlerResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.set(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set pagerduty handler", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyPagerdutyHandlerResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyPagerdutyHandlerResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	handlerType, err := r.provider.healthCheckHandlerType(orgId, data.Name.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+	if !strings.EqualFold(handlerType, "pagerduty") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.String{Value: data.Name.Value}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyPagerdutyHandlerResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data flyPagerdutyHandlerResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// setPagerdutyHandler replaces the handler with the same name, so updating is setting it again
+	data, err := r.set(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set pagerduty handler", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyPagerdutyHandlerResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyPagerdutyHandlerResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	_, err = graphql.DeleteHealthCheckHandlerMutation(context.Background(), *r.provider.client, orgId, data.Name.Value)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Delete pagerduty handler failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyPagerdutyHandlerResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	importHealthCheckHandler(ctx, req, resp)
+}
+
+func (r flyPagerdutyHandlerResource) set(data flyPagerdutyHandlerResourceData) (flyPagerdutyHandlerResourceData, error) {
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		return data, err
+	}
+
+	var statusMap interface{}
+	if !data.StatusMap.Null && !data.StatusMap.Unknown {
+		statuses := map[string]string{}
+		for status, severity := range data.StatusMap.Elems {
+			statuses[status] = severity.(types.String).Value
+		}
+		statusMap = statuses
+	}
+
+	_, err = graphql.SetPagerdutyHandlerMutation(context.Background(), *r.provider.client, orgId, data.Name.Value, data.Token.Value, statusMap)
+	if err != nil {
+		return data, err
+	}
+
+	if data.Org.Unknown || data.Org.Null {
+		data.Org = types.String{Value: orgId}
+	}
+	data.Id = types.String{Value: data.Name.Value}
+	return data, nil
+}
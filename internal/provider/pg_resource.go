@@ -4,7 +4,6 @@ import (
 	"context"
 	"dov.dev/fly/fly-provider/graphql"
 	"dov.dev/fly/fly-provider/internal/provider/modifiers"
-	"dov.dev/fly/fly-provider/internal/utils"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -40,7 +39,7 @@ func (t flyPgResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagno
 		MarkdownDescription: "Fly postgres resource",
 		Attributes: map[string]tfsdk.Attribute{
 			"org": {
-				MarkdownDescription: "Optional org ID to operate upon",
+				MarkdownDescription: "Optional org slug or ID to operate upon, defaults to the provider org",
 				Computed:            true,
 				Optional:            true,
 				Type:                types.StringType,
@@ -97,13 +96,13 @@ func (r flyPgResource) Create(ctx context.Context, req tfsdk.CreateResourceReque
 	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not detect default organization", err.Error())
+		return
+	}
 	if data.Org.Unknown {
-		defaultOrg, err := utils.GetDefaultOrg(*r.provider.client)
-		if err != nil {
-			resp.Diagnostics.AddError("Could not detect default organization", err.Error())
-			return
-		}
-		data.Org.Value = defaultOrg.Id
+		data.Org.Value = orgId
 	}
 
 	if data.Volumesize.Unknown {
@@ -113,7 +112,7 @@ func (r flyPgResource) Create(ctx context.Context, req tfsdk.CreateResourceReque
 		data.Count.Value = 1
 	}
 
-	q, err := graphql.CreatePostgresCluster(context.Background(), *r.provider.client, data.Name.Value, data.Org.Value, data.Region.Value, data.Password.Value, data.Vmsize.Value, int(data.Volumesize.Value), int(data.Count.Value), "flyio/postgres")
+	q, err := graphql.CreatePostgresCluster(context.Background(), *r.provider.client, data.Name.Value, orgId, data.Region.Value, data.Password.Value, data.Vmsize.Value, int(data.Volumesize.Value), int(data.Count.Value), "flyio/postgres")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Postgres cluster", err.Error())
 	}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/provider/modifiers"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyPostgresAttachmentResourceType{}
+var _ tfsdk.Resource = flyPostgresAttachmentResource{}
+var _ tfsdk.ResourceWithImportState = flyPostgresAttachmentResource{}
+
+type flyPostgresAttachmentResourceType struct{}
+
+type flyPostgresAttachmentResource struct {
+	provider provider
+}
+
+type flyPostgresAttachmentResourceData struct {
+	Id               types.String `tfsdk:"id"`
+	PostgresApp      types.String `tfsdk:"postgres_app"`
+	App              types.String `tfsdk:"app"`
+	DatabaseName     types.String `tfsdk:"database_name"`
+	DatabaseUser     types.String `tfsdk:"database_user"`
+	VariableName     types.String `tfsdk:"variable_name"`
+	ConnectionString types.String `tfsdk:"connection_string"`
+}
+
+func (t flyPostgresAttachmentResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly postgres attachment resource, creates a database and user on a postgres app and sets the connection string as a secret on the consuming app.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of attachment",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"postgres_app": {
+				MarkdownDescription: "Name of the postgres app",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"app": {
+				MarkdownDescription: "Name of the app to attach postgres to",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"database_name": {
+				MarkdownDescription: "Database to attach, defaults to a new database named after the app",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"database_user": {
+				MarkdownDescription: "Database user to create, defaults to the database name",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"variable_name": {
+				MarkdownDescription: "Secret the connection string is stored in, defaults to DATABASE_URL",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					modifiers.StringDefault("DATABASE_URL"),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"connection_string": {
+				MarkdownDescription: "Connection string of the attached database. Only known when terraform created the attachment",
+				Computed:            true,
+				Sensitive:           true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyPostgresAttachmentResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyPostgresAttachmentResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyPostgresAttachmentResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyPostgresAttachmentResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apps, err := graphql.PostgresAttachmentAppsQuery(context.Background(), *r.provider.client, data.PostgresApp.Value, data.App.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Query apps failed", err.Error())
+		return
+	}
+
+	attached, err := graphql.AttachPostgresClusterMutation(context.Background(), *r.provider.client, apps.PostgresApp.Id, apps.App.Id, data.DatabaseName.Value, data.DatabaseUser.Value, data.VariableName.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Attach postgres failed", err.Error())
+		return
+	}
+
+	// The mutation doesn't say which attachment it created, find it by the secret it set
+	data.VariableName = types.String{Value: attached.AttachPostgresCluster.EnvironmentVariableName}
+	data.ConnectionString = types.String{Value: attached.AttachPostgresCluster.ConnectionString}
+	attachment, err := r.findAttachment(data)
+	if err == nil && attachment == nil {
+		err = fmt.Errorf("attachment for %s not found", data.VariableName.Value)
+	}
+	if err != nil {
+		// The attachment exists regardless, save what is known so it is tainted rather than leaked. Read and Delete
+		// find it by its variable name while the ID is empty.
+		data.Id = types.String{Value: ""}
+		if data.DatabaseName.Unknown {
+			data.DatabaseName = types.String{Null: true}
+		}
+		if data.DatabaseUser.Unknown {
+			data.DatabaseUser = types.String{Null: true}
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Read after attach failed", err.Error())
+		return
+	}
+
+	data = attachmentData(data, *attachment)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyPostgresAttachmentResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyPostgresAttachmentResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachment, err := r.findAttachment(data)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+	if attachment == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = attachmentData(data, *attachment)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyPostgresAttachmentResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError("The fly api does not allow updating postgres attachments once created", "Try deleting and then recreating the attachment with new options")
+	return
+}
+
+func (r flyPostgresAttachmentResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyPostgresAttachmentResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apps, err := graphql.PostgresAttachmentAppsQuery(context.Background(), *r.provider.client, data.PostgresApp.Value, data.App.Value)
+	if utils.IsNotFound(err) {
+		// Either app being gone takes the attachment with it
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Query apps failed", err.Error())
+		return
+	}
+
+	if data.Id.Value == "" {
+		// Create couldn't read the attachment back, look it up by variable name so only this one is detached
+		attachment, err := r.findAttachment(data)
+		if err != nil {
+			resp.Diagnostics.AddError("Query attachments failed", err.Error())
+			return
+		}
+		if attachment == nil {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.Id = types.String{Value: attachment.Id}
+	}
+
+	_, err = graphql.DetachPostgresClusterMutation(context.Background(), *r.provider.client, apps.PostgresApp.Id, apps.App.Id, data.Id.Value)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Detach postgres failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyPostgresAttachmentResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" || (len(parts) == 3 && parts[2] == "") {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected postgres_app/app or postgres_app/app/variable_name, got %q", req.ID))
+		return
+	}
+	variable := "DATABASE_URL"
+	if len(parts) == 3 {
+		variable = parts[2]
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("postgres_app"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("variable_name"), variable)...)
+}
+
+// findAttachment looks the attachment up by id, or by its secret when the id isn't known yet after create or import.
+// Returns nil when there is no such attachment.
+func (r flyPostgresAttachmentResource) findAttachment(data flyPostgresAttachmentResourceData) (*graphql.PostgresAttachmentFragment, error) {
+	query, err := graphql.PostgresAttachmentsQuery(context.Background(), *r.provider.client, data.App.Value, data.PostgresApp.Value)
+	if err != nil {
+		return nil, err
+	}
+	for _, attachment := range query.PostgresAttachments.Nodes {
+		if data.Id.Value != "" && attachment.Id == data.Id.Value {
+			return &attachment.PostgresAttachmentFragment, nil
+		}
+		if data.Id.Value == "" && attachment.EnvironmentVariableName == data.VariableName.Value {
+			return &attachment.PostgresAttachmentFragment, nil
+		}
+	}
+	return nil, nil
+}
+
+func attachmentData(data flyPostgresAttachmentResourceData, attachment graphql.PostgresAttachmentFragment) flyPostgresAttachmentResourceData {
+	data.Id = types.String{Value: attachment.Id}
+	data.DatabaseName = types.String{Value: attachment.DatabaseName}
+	data.DatabaseUser = types.String{Value: attachment.DatabaseUser}
+	data.VariableName = types.String{Value: attachment.EnvironmentVariableName}
+	return data
+}
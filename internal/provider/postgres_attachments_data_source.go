@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = postgresAttachmentsDataSourceType{}
+var _ tfsdk.DataSource = postgresAttachmentsDataSource{}
+
+type postgresAttachmentsDataSourceType struct{}
+
+// Matches getSchema
+type postgresAttachmentsDataSourceOutput struct {
+	Id          types.String                   `tfsdk:"id"`
+	PostgresApp types.String                   `tfsdk:"postgres_app"`
+	App         types.String                   `tfsdk:"app"`
+	Attachments []postgresAttachmentOutputData `tfsdk:"attachments"`
+}
+
+type postgresAttachmentOutputData struct {
+	Id           types.String `tfsdk:"id"`
+	DatabaseName types.String `tfsdk:"database_name"`
+	DatabaseUser types.String `tfsdk:"database_user"`
+	VariableName types.String `tfsdk:"variable_name"`
+}
+
+func (t postgresAttachmentsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Retrieve the attachments between a postgres app and an app",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "postgres_app/app",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"postgres_app": {
+				MarkdownDescription: "Name of the postgres app",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"app": {
+				MarkdownDescription: "Name of the attached app",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"attachments": {
+				MarkdownDescription: "Attachments of the app",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "ID of attachment",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"database_name": {
+						MarkdownDescription: "Attached database",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"database_user": {
+						MarkdownDescription: "Database user of the app",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"variable_name": {
+						MarkdownDescription: "Secret the connection string is stored in",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t postgresAttachmentsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return postgresAttachmentsDataSource{
+		provider: provider,
+	}, diags
+}
+
+func (d postgresAttachmentsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data postgresAttachmentsDataSourceOutput
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := graphql.PostgresAttachmentsQuery(context.Background(), *d.provider.client, data.App.Value, data.PostgresApp.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Query failed", err.Error())
+		return
+	}
+
+	data.Id = types.String{Value: data.PostgresApp.Value + "/" + data.App.Value}
+	data.Attachments = []postgresAttachmentOutputData{}
+	for _, attachment := range query.PostgresAttachments.Nodes {
+		data.Attachments = append(data.Attachments, postgresAttachmentOutputData{
+			Id:           types.String{Value: attachment.Id},
+			DatabaseName: types.String{Value: attachment.DatabaseName},
+			DatabaseUser: types.String{Value: attachment.DatabaseUser},
+			VariableName: types.String{Value: attachment.EnvironmentVariableName},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyPostgresDatabaseResourceType{}
+var _ tfsdk.Resource = flyPostgresDatabaseResource{}
+var _ tfsdk.ResourceWithImportState = flyPostgresDatabaseResource{}
+
+type flyPostgresDatabaseResourceType struct{}
+
+type flyPostgresDatabaseResource struct {
+	provider provider
+}
+
+type flyPostgresDatabaseResourceData struct {
+	Id   types.String `tfsdk:"id"`
+	App  types.String `tfsdk:"app"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (t flyPostgresDatabaseResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly postgres database resource. The api can't drop databases, so destroying it only removes it from state.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "app/name",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"app": {
+				MarkdownDescription: "Name of the postgres cluster app",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Name of database",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyPostgresDatabaseResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyPostgresDatabaseResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyPostgresDatabaseResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyPostgresDatabaseResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := graphql.CreatePostgresClusterDatabaseMutation(context.Background(), *r.provider.client, data.App.Value, data.Name.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create postgres database", err.Error())
+		return
+	}
+
+	data.Id = types.String{Value: data.App.Value + "/" + data.Name.Value}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyPostgresDatabaseResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyPostgresDatabaseResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.provider.postgresRole(data.App.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	for _, database := range role.Databases {
+		if database.Name == data.Name.Value {
+			data.Id = types.String{Value: data.App.Value + "/" + data.Name.Value}
+			diags = resp.State.Set(ctx, &data)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyPostgresDatabaseResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError("The fly api does not allow updating postgres databases once created", "Try deleting and then recreating the database with new options")
+	return
+}
+
+func (r flyPostgresDatabaseResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyPostgresDatabaseResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.AddWarning("Postgres database not dropped", fmt.Sprintf("The fly api can't drop databases, %s still exists on %s", data.Name.Value, data.App.Value))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyPostgresDatabaseResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected app/name, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("name"), parts[1])...)
+}
+
+// postgresRole returns the databases and users of a postgres cluster app, shared by the postgres database, user and
+// grant resources
+func (p provider) postgresRole(app string) (*graphql.PostgresClusterRoleQueryAppRolePostgresClusterAppRole, error) {
+	query, err := graphql.PostgresClusterRoleQuery(context.Background(), *p.client, app)
+	if err != nil {
+		return nil, err
+	}
+	role, ok := query.App.Role.(*graphql.PostgresClusterRoleQueryAppRolePostgresClusterAppRole)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a postgres cluster app", app)
+	}
+	return role, nil
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyPostgresGrantResourceType{}
+var _ tfsdk.Resource = flyPostgresGrantResource{}
+var _ tfsdk.ResourceWithImportState = flyPostgresGrantResource{}
+
+type flyPostgresGrantResourceType struct{}
+
+type flyPostgresGrantResource struct {
+	provider provider
+}
+
+type flyPostgresGrantResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	App      types.String `tfsdk:"app"`
+	Username types.String `tfsdk:"username"`
+	Database types.String `tfsdk:"database"`
+}
+
+func (t flyPostgresGrantResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly postgres grant resource, gives a user access to a database. Destroying it revokes the access.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "app/username/database",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"app": {
+				MarkdownDescription: "Name of the postgres cluster app",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"username": {
+				MarkdownDescription: "User to grant access to",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"database": {
+				MarkdownDescription: "Database to grant access to",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyPostgresGrantResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyPostgresGrantResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyPostgresGrantResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyPostgresGrantResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := graphql.GrantPostgresClusterUserAccessMutation(context.Background(), *r.provider.client, data.App.Value, data.Username.Value, data.Database.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to grant postgres access", err.Error())
+		return
+	}
+
+	data.Id = types.String{Value: strings.Join([]string{data.App.Value, data.Username.Value, data.Database.Value}, "/")}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyPostgresGrantResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyPostgresGrantResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.provider.postgresRole(data.App.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	for _, user := range role.Users {
+		if user.Username != data.Username.Value {
+			continue
+		}
+		for _, database := range user.Databases {
+			if database == data.Database.Value {
+				data.Id = types.String{Value: strings.Join([]string{data.App.Value, data.Username.Value, data.Database.Value}, "/")}
+				diags = resp.State.Set(ctx, &data)
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyPostgresGrantResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError("The fly api does not allow updating postgres grants once created", "Try deleting and then recreating the grant with new options")
+	return
+}
+
+func (r flyPostgresGrantResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyPostgresGrantResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	_, err := graphql.RevokePostgresClusterUserAccessMutation(context.Background(), *r.provider.client, data.App.Value, data.Username.Value, data.Database.Value)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Revoke postgres access failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyPostgresGrantResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected app/username/database, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("username"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("database"), parts[2])...)
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flyPostgresUserResourceType{}
+var _ tfsdk.Resource = flyPostgresUserResource{}
+var _ tfsdk.ResourceWithImportState = flyPostgresUserResource{}
+var _ tfsdk.ResourceWithModifyPlan = flyPostgresUserResource{}
+
+type flyPostgresUserResourceType struct{}
+
+type flyPostgresUserResource struct {
+	provider provider
+}
+
+type flyPostgresUserResourceData struct {
+	Id        types.String `tfsdk:"id"`
+	App       types.String `tfsdk:"app"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	Superuser types.Bool   `tfsdk:"superuser"`
+}
+
+func (t flyPostgresUserResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly postgres user resource. Changing the password or superuser flag replaces the user. The api can't drop users, so destroying it only removes it from state.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "app/username",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"app": {
+				MarkdownDescription: "Name of the postgres cluster app",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"username": {
+				MarkdownDescription: "Name of user",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"password": {
+				MarkdownDescription: "Password of user, e.g. from a random_password resource. Changing it replaces the user",
+				Required:            true,
+				Sensitive:           true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, path *tftypes.AttributePath) (bool, diag.Diagnostics) {
+						// Imported users have no password in state, taking the configured one doesn't need a new user
+						password, ok := state.(types.String)
+						return ok && !password.Null, nil
+					}, "Changing the password replaces the user", "Changing the password replaces the user"),
+				},
+			},
+			"superuser": {
+				MarkdownDescription: "Whether the user is a superuser, defaults to false. Changing it replaces the user",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyPostgresUserResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyPostgresUserResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyPostgresUserResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyPostgresUserResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q, err := graphql.CreatePostgresClusterUserMutation(context.Background(), *r.provider.client, data.App.Value, data.Username.Value, data.Password.Value, data.Superuser.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create postgres user", err.Error())
+		return
+	}
+
+	data.Id = types.String{Value: data.App.Value + "/" + data.Username.Value}
+	data.Superuser = types.Bool{Value: q.CreatePostgresClusterUser.User.IsSuperuser}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyPostgresUserResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyPostgresUserResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.provider.postgresRole(data.App.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	// The password can't be read back, only existence and superuser drift
+	for _, user := range role.Users {
+		if user.Username == data.Username.Value {
+			data.Id = types.String{Value: data.App.Value + "/" + data.Username.Value}
+			data.Superuser = types.Bool{Value: user.IsSuperuser}
+			diags = resp.State.Set(ctx, &data)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyPostgresUserResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan flyPostgresUserResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other change replaces the user, so this is an imported user picking up its configured password
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan fails the plan for a user that already exists, creating it again would fail at apply
+func (r flyPostgresUserResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan flyPostgresUserResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.App.Unknown || plan.Username.Unknown {
+		return
+	}
+
+	// The cluster may not exist yet, only an existing user is worth failing on
+	role, err := r.provider.postgresRole(plan.App.Value)
+	if err != nil {
+		return
+	}
+	for _, user := range role.Users {
+		if user.Username == plan.Username.Value {
+			resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("username"), "Postgres user already exists",
+				fmt.Sprintf("%s already exists on %s and the fly api can't drop it, import it with terraform import instead", plan.Username.Value, plan.App.Value))
+		}
+	}
+}
+
+func (r flyPostgresUserResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyPostgresUserResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.AddWarning("Postgres user not dropped", fmt.Sprintf("The fly api can't drop users, %s still exists on %s", data.Username.Value, data.App.Value))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyPostgresUserResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected app/username, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("username"), parts[1])...)
+}
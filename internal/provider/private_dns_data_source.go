@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/internal/provider/validators"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = privateDnsDataSourceType{}
+var _ tfsdk.DataSource = privateDnsDataSource{}
+
+type privateDnsDataSourceType struct{}
+
+// Matches getSchema
+type privateDnsDataSourceOutput struct {
+	Id      types.String   `tfsdk:"id"`
+	Org     types.String   `tfsdk:"org"`
+	Region  types.String   `tfsdk:"region"`
+	Name    types.String   `tfsdk:"name"`
+	Type    types.String   `tfsdk:"type"`
+	Records []types.String `tfsdk:"records"`
+}
+
+func (t privateDnsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Resolve names on the private network, e.g. `<app>.internal` or `_apps.internal`, through a wireguard tunnel",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Name and record type that were queried",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"org": {
+				MarkdownDescription: "Optional org slug or ID whose private network to query, defaults to the provider org",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"region": {
+				MarkdownDescription: "Region of the wireguard gateway to tunnel through",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"name": {
+				MarkdownDescription: "Name to look up",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"type": {
+				MarkdownDescription: "Record type to query, AAAA or TXT. Defaults to AAAA",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringOneOf("AAAA", "TXT"),
+				},
+			},
+			"records": {
+				MarkdownDescription: "Addresses for AAAA lookups, record text for TXT lookups",
+				Computed:            true,
+				Type:                types.ListType{ElemType: types.StringType},
+			},
+		},
+	}, nil
+}
+
+func (t privateDnsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return privateDnsDataSource{
+		provider: provider,
+	}, diags
+}
+
+func (d privateDnsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data privateDnsDataSourceOutput
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := d.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	recordType := "AAAA"
+	if !data.Type.Null && !data.Type.Unknown {
+		recordType = strings.ToUpper(data.Type.Value)
+	}
+
+	tunnel, err := d.provider.tunnel(ctx, orgId, data.Region.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not open wireguard tunnel", err.Error())
+		return
+	}
+	defer tunnel.Down()
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(data.Name.Value), dns.StringToType[recordType])
+	answer, err := tunnel.QueryDNS(ctx, msg)
+	if err != nil {
+		resp.Diagnostics.AddError("DNS query failed", err.Error())
+		return
+	}
+	if answer.Rcode != dns.RcodeSuccess {
+		resp.Diagnostics.AddError("DNS query failed", fmt.Sprintf("%s lookup of %s returned %s", recordType, data.Name.Value, dns.RcodeToString[answer.Rcode]))
+		return
+	}
+
+	data.Records = []types.String{}
+	for _, rr := range answer.Answer {
+		switch record := rr.(type) {
+		case *dns.AAAA:
+			data.Records = append(data.Records, types.String{Value: record.AAAA.String()})
+		case *dns.TXT:
+			data.Records = append(data.Records, types.String{Value: strings.Join(record.Txt, "")})
+		}
+	}
+
+	data.Id = types.String{Value: data.Name.Value + "/" + recordType}
+	data.Type = types.String{Value: recordType}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
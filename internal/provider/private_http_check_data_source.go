@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = privateHttpCheckDataSourceType{}
+var _ tfsdk.DataSource = privateHttpCheckDataSource{}
+
+type privateHttpCheckDataSourceType struct{}
+
+// Matches getSchema
+type privateHttpCheckDataSourceOutput struct {
+	Id             types.String `tfsdk:"id"`
+	Org            types.String `tfsdk:"org"`
+	Region         types.String `tfsdk:"region"`
+	Url            types.String `tfsdk:"url"`
+	Method         types.String `tfsdk:"method"`
+	ExpectedStatus types.Int64  `tfsdk:"expected_status"`
+	Retries        types.Int64  `tfsdk:"retries"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+	Status         types.Int64  `tfsdk:"status"`
+	LatencyMs      types.Int64  `tfsdk:"latency_ms"`
+}
+
+func (t privateHttpCheckDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Check that an http service is reachable on the private network. Fails when the expected status is not returned within the given retries.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "URL that was checked",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"org": {
+				MarkdownDescription: "Optional org slug or ID whose private network to use, defaults to the provider org",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"region": {
+				MarkdownDescription: "Region of the wireguard gateway to tunnel through",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"url": {
+				MarkdownDescription: "URL to request, e.g. `http://myapp.internal:8080/health`",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"method": {
+				MarkdownDescription: "HTTP method. Defaults to GET",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"expected_status": {
+				MarkdownDescription: "Status code that counts as healthy. Defaults to 200",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"retries": {
+				MarkdownDescription: "How many times to retry before failing. Defaults to 3",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"timeout": {
+				MarkdownDescription: "Seconds each attempt may take. Defaults to 10",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"status": {
+				MarkdownDescription: "Status code of the last response",
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"latency_ms": {
+				MarkdownDescription: "Duration of the successful request in milliseconds",
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+		},
+	}, nil
+}
+
+func (t privateHttpCheckDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return privateHttpCheckDataSource{
+		provider: provider,
+	}, diags
+}
+
+func (d privateHttpCheckDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data privateHttpCheckDataSourceOutput
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Method.Null || data.Method.Unknown {
+		data.Method = types.String{Value: http.MethodGet}
+	}
+	if data.ExpectedStatus.Null || data.ExpectedStatus.Unknown {
+		data.ExpectedStatus = types.Int64{Value: http.StatusOK}
+	}
+	if data.Retries.Null || data.Retries.Unknown {
+		data.Retries = types.Int64{Value: 3}
+	}
+	if data.Timeout.Null || data.Timeout.Unknown {
+		data.Timeout = types.Int64{Value: 10}
+	}
+
+	orgId, err := d.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	tunnel, err := d.provider.tunnel(ctx, orgId, data.Region.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not open wireguard tunnel", err.Error())
+		return
+	}
+	defer tunnel.Down()
+
+	client := http.Client{
+		Timeout: time.Duration(data.Timeout.Value) * time.Second,
+		Transport: &http.Transport{
+			DialContext:       tunnel.DialContext,
+			DisableKeepAlives: true,
+		},
+	}
+
+	var lastErr error
+	for attempt := int64(0); attempt <= data.Retries.Value; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Second)
+		}
+
+		request, err := http.NewRequestWithContext(ctx, data.Method.Value, data.Url.Value, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid request", err.Error())
+			return
+		}
+
+		start := time.Now()
+		response, err := client.Do(request)
+		if err != nil {
+			lastErr = err
+			tflog.Debug(ctx, fmt.Sprintf("private http check attempt %d failed: %s", attempt+1, err))
+			continue
+		}
+		io.Copy(ioutil.Discard, response.Body)
+		response.Body.Close()
+		latency := time.Since(start)
+
+		data.Status = types.Int64{Value: int64(response.StatusCode)}
+		if int64(response.StatusCode) != data.ExpectedStatus.Value {
+			lastErr = fmt.Errorf("got status %d, expected %d", response.StatusCode, data.ExpectedStatus.Value)
+			tflog.Debug(ctx, fmt.Sprintf("private http check attempt %d failed: %s", attempt+1, lastErr))
+			continue
+		}
+
+		data.Id = data.Url
+		data.LatencyMs = types.Int64{Value: latency.Milliseconds()}
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.AddError("Private http check failed", fmt.Sprintf("%s %s: %s", data.Method.Value, data.Url.Value, lastErr))
+}
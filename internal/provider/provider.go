@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	flygql "dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/provider/validators"
 	"dov.dev/fly/fly-provider/internal/utils"
+	"dov.dev/fly/fly-provider/internal/wg"
 	"fmt"
 	"github.com/Khan/genqlient/graphql"
 	"net/http"
@@ -21,10 +24,18 @@ type provider struct {
 	version    string
 	token      string
 	client     *graphql.Client
+	user       flygql.ViewerQueryViewerUser
+	orgs       []flygql.OrgsQueryOrganizationsOrganizationConnectionNodesOrganization
+	org        *flygql.OrgQueryOrganization
+	wgStore    *wg.StateStore
+	wgOptions  wg.TunnelOptions
+	tunnels    *wg.Pool
 }
 
 type providerData struct {
-	FlyToken types.String `tfsdk:"flytoken"`
+	FlyToken  types.String            `tfsdk:"flytoken"`
+	Org       types.String            `tfsdk:"org"`
+	Wireguard []providerWireguardData `tfsdk:"wireguard"`
 }
 
 func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
@@ -59,29 +70,92 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 	// TODO: Make timeout configurable
 	h := http.Client{Timeout: 60 * time.Second, Transport: &utils.Transport{UnderlyingTransport: http.DefaultTransport, Token: token, Ctx: ctx}}
 	client := graphql.NewClient("https://api.fly.io/graphql", &h)
+
+	// Resolve the token owner up front so a bad token fails here instead of halfway through the first resource
+	viewer, err := flygql.ViewerQuery(ctx, client)
+	if utils.IsUnauthorized(err) {
+		resp.Diagnostics.AddError(
+			"Invalid fly.io api token",
+			"The fly.io api rejected the configured token (401 Unauthorized). Check flytoken or the FLY_TOKEN env variable",
+		)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Unable to verify token", err.Error())
+		return
+	}
+
+	orgs, err := utils.ListOrgs(client)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list organizations", err.Error())
+		return
+	}
+
+	if data.Org.Unknown {
+		resp.Diagnostics.AddError("Unable to resolve organization", "Cannot use unknown value as org")
+		return
+	}
+	if !data.Org.Null && data.Org.Value != "" {
+		org, err := utils.ResolveOrg(client, data.Org.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to resolve organization", err.Error())
+			return
+		}
+		p.org = org
+	}
+
 	p.client = &client
 	p.token = token
+	p.user = viewer.Viewer
+	p.orgs = orgs
+
+	resp.Diagnostics.Append(p.configureWireguard(ctx, data.Wireguard)...)
 
 	p.configured = true
 }
 
 func (p *provider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
-		"fly_app":    flyAppResourceType{},
-		"fly_volume": flyVolumeResourceType{},
-		"fly_ip":     flyIpResourceType{},
-		"fly_cert":   flyCertResourceType{},
+		"fly_app":             flyAppResourceType{},
+		"fly_app_config":      flyAppConfigResourceType{},
+		"fly_app_autoscaling": flyAppAutoscalingResourceType{},
+		"fly_app_regions":     flyAppRegionsResourceType{},
+		"fly_app_scale":       flyAppScaleResourceType{},
+		"fly_deployment":      flyDeploymentResourceType{},
+		"fly_volume":          flyVolumeResourceType{},
+		"fly_ip":              flyIpResourceType{},
+		"fly_cert":            flyCertResourceType{},
+		"fly_check_job":       flyCheckJobResourceType{},
 		"fly_machine": flyMachineResourceType{
 			Token: p.token,
 		},
+		"fly_organization":            flyOrganizationResourceType{},
+		"fly_organization_member":     flyOrganizationMemberResourceType{},
+		"fly_organization_invitation": flyOrganizationInvitationResourceType{},
+		"fly_pagerduty_handler":       flyPagerdutyHandlerResourceType{},
+		"fly_postgres_attachment":     flyPostgresAttachmentResourceType{},
+		"fly_postgres_database":       flyPostgresDatabaseResourceType{},
+		"fly_postgres_grant":          flyPostgresGrantResourceType{},
+		"fly_postgres_user":           flyPostgresUserResourceType{},
+		"fly_slack_handler":           flySlackHandlerResourceType{},
+		"fly_wireguard_peer":          flyWireguardPeerResourceType{},
+		"fly_wireguard_token":         flyWireguardTokenResourceType{},
 	}, nil
 }
 
 func (p *provider) GetDataSources(ctx context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	return map[string]tfsdk.DataSourceType{
-		"fly_app":  appDataSourceType{},
-		"fly_cert": certDataSourceType{},
-		"fly_ip":   ipDataSourceType{},
+		"fly_app":                  appDataSourceType{},
+		"fly_app_release":          appReleaseDataSourceType{},
+		"fly_app_releases":         appReleasesDataSourceType{},
+		"fly_cert":                 certDataSourceType{},
+		"fly_check_job_run":        checkJobRunDataSourceType{},
+		"fly_check_locations":      checkLocationsDataSourceType{},
+		"fly_ip":                   ipDataSourceType{},
+		"fly_current_user":         currentUserDataSourceType{},
+		"fly_organization":         organizationDataSourceType{},
+		"fly_postgres_attachments": postgresAttachmentsDataSourceType{},
+		"fly_private_dns":          privateDnsDataSourceType{},
+		"fly_private_http_check":   privateHttpCheckDataSourceType{},
 	}, nil
 }
 
@@ -93,14 +167,101 @@ func (p *provider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostic
 				Optional:            true,
 				Type:                types.StringType,
 			},
+			"org": {
+				MarkdownDescription: "Default organization slug or ID for resources that don't set one",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"wireguard": {
+				MarkdownDescription: "Settings for the wireguard tunnel the provider opens into private networks",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				MaxItems:            1,
+				Attributes: map[string]tfsdk.Attribute{
+					"state_file": {
+						MarkdownDescription: "Where to cache tunnel keys and peers between runs, setting it turns on `persist_state`. Defaults to fly-provider/wireguard.json in the user config dir",
+						Optional:            true,
+						Type:                types.StringType,
+					},
+					"persist_state": {
+						MarkdownDescription: "Reuse peers across runs instead of registering and removing a throwaway one every time. Defaults to false. Don't share a state file between concurrent terraform runs",
+						Optional:            true,
+						Type:                types.BoolType,
+					},
+					"cleanup_stale_peers": {
+						MarkdownDescription: "Remove terraform owned peers registered from this machine that are no longer cached",
+						Optional:            true,
+						Type:                types.BoolType,
+					},
+					"mtu": {
+						MarkdownDescription: "MTU of the tunnel interface. Defaults to 1420",
+						Optional:            true,
+						Type:                types.Int64Type,
+					},
+					"keepalive": {
+						MarkdownDescription: "Persistent keepalive interval in seconds, off by default",
+						Optional:            true,
+						Type:                types.Int64Type,
+					},
+					"log_level": {
+						MarkdownDescription: "Verbosity of wireguard logs, which are sent to the terraform log. One of silent, error or verbose. Defaults to error",
+						Optional:            true,
+						Type:                types.StringType,
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringOneOf("silent", "error", "verbose"),
+						},
+					},
+					"handshake_timeout": {
+						MarkdownDescription: "Seconds to wait for a handshake with each gateway address before trying the next one. Defaults to 10",
+						Optional:            true,
+						Type:                types.Int64Type,
+					},
+				},
+			},
 		},
 	}, nil
 }
 
+// orgId resolves the organization a resource should operate on. A value set on the resource wins, then the provider
+// level org, then the account's only organization.
+func (p provider) orgId(value types.String) (string, error) {
+	if !value.Unknown && !value.Null && value.Value != "" {
+		for _, o := range p.orgs {
+			if o.Id == value.Value || o.Slug == value.Value {
+				return o.Id, nil
+			}
+		}
+		org, err := utils.ResolveOrg(*p.client, value.Value)
+		if err != nil {
+			return "", err
+		}
+		return org.Id, nil
+	}
+	if p.org != nil {
+		return p.org.Id, nil
+	}
+	org, err := utils.GetDefaultOrg(*p.client)
+	if err != nil {
+		return "", err
+	}
+	return org.Id, nil
+}
+
+// orgValue picks what to store for a resource's org attribute, keeping the slug if that's what was configured so
+// that it doesn't show up as drift.
+func orgValue(configured types.String, id string, slug string) types.String {
+	if !configured.Unknown && !configured.Null && configured.Value == slug {
+		return types.String{Value: slug}
+	}
+	return types.String{Value: id}
+}
+
 func New(version string) func() tfsdk.Provider {
 	return func() tfsdk.Provider {
 		return &provider{
 			version: version,
+			tunnels: &wg.Pool{},
 		}
 	}
 }
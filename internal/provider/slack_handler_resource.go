@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+)
+
+var _ tfsdk.ResourceType = flySlackHandlerResourceType{}
+var _ tfsdk.Resource = flySlackHandlerResource{}
+var _ tfsdk.ResourceWithImportState = flySlackHandlerResource{}
+
+type flySlackHandlerResourceType struct{}
+
+type flySlackHandlerResource struct {
+	provider provider
+}
+
+type flySlackHandlerResourceData struct {
+	Id         types.String `tfsdk:"id"`
+	Org        types.String `tfsdk:"org"`
+	Name       types.String `tfsdk:"name"`
+	WebhookUrl types.String `tfsdk:"webhook_url"`
+	Channel    types.String `tfsdk:"channel"`
+	Username   types.String `tfsdk:"username"`
+	IconUrl    types.String `tfsdk:"icon_url"`
+}
+
+func (t flySlackHandlerResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly slack handler resource, posts health check alerts of an organization to slack. The api never hands settings back, so only removal is detected.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of handler, same as name",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"org": {
+				MarkdownDescription: "Optional org slug or ID to operate upon, defaults to the provider org",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Name of handler",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"webhook_url": {
+				MarkdownDescription: "Slack webhook URL to post alerts to",
+				Required:            true,
+				Sensitive:           true,
+				Type:                types.StringType,
+			},
+			"channel": {
+				MarkdownDescription: "Channel to post to, defaults to #general",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"username": {
+				MarkdownDescription: "Name to post as, defaults to Fly",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"icon_url": {
+				MarkdownDescription: "URL of the icon to post with",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t flySlackHandlerResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flySlackHandlerResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flySlackHandlerResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flySlackHandlerResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.set(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set slack handler", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flySlackHandlerResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flySlackHandlerResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	handlerType, err := r.provider.healthCheckHandlerType(orgId, data.Name.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+	if !strings.EqualFold(handlerType, "slack") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.String{Value: data.Name.Value}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flySlackHandlerResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data flySlackHandlerResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// setSlackHandler replaces the handler with the same name, so updating is setting it again
+	data, err := r.set(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set slack handler", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flySlackHandlerResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flySlackHandlerResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	_, err = graphql.DeleteHealthCheckHandlerMutation(context.Background(), *r.provider.client, orgId, data.Name.Value)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Delete slack handler failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flySlackHandlerResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	importHealthCheckHandler(ctx, req, resp)
+}
+
+func (r flySlackHandlerResource) set(data flySlackHandlerResourceData) (flySlackHandlerResourceData, error) {
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		return data, err
+	}
+
+	_, err = graphql.SetSlackHandlerMutation(context.Background(), *r.provider.client, orgId, data.Name.Value, data.WebhookUrl.Value, data.Channel.Value, data.Username.Value, data.IconUrl.Value)
+	if err != nil {
+		return data, err
+	}
+
+	if data.Org.Unknown || data.Org.Null {
+		data.Org = types.String{Value: orgId}
+	}
+	data.Id = types.String{Value: data.Name.Value}
+	return data, nil
+}
+
+// healthCheckHandlerType returns the type of the named handler, or an empty string if the org has no such handler
+func (p provider) healthCheckHandlerType(orgId string, name string) (string, error) {
+	query, err := graphql.HealthCheckHandlersQuery(context.Background(), *p.client, orgId)
+	if err != nil {
+		return "", err
+	}
+	for _, handler := range query.Organization.HealthCheckHandlers.Nodes {
+		if handler.Name == name {
+			return handler.Type, nil
+		}
+	}
+	return "", nil
+}
+
+// importHealthCheckHandler takes org/name, secrets can't be read back so they stay empty until the next apply
+func importHealthCheckHandler(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected org/name, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("org"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("name"), parts[1])...)
+}
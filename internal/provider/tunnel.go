@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/internal/wg"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.zx2c4.com/wireguard/device"
+)
+
+// Matches the wireguard block in the provider schema
+type providerWireguardData struct {
+	StateFile         types.String `tfsdk:"state_file"`
+	PersistState      types.Bool   `tfsdk:"persist_state"`
+	CleanupStalePeers types.Bool   `tfsdk:"cleanup_stale_peers"`
+	Mtu               types.Int64  `tfsdk:"mtu"`
+	KeepAlive         types.Int64  `tfsdk:"keepalive"`
+	LogLevel          types.String `tfsdk:"log_level"`
+	HandshakeTimeout  types.Int64  `tfsdk:"handshake_timeout"`
+}
+
+var wireguardLogLevels = map[string]int{
+	"silent":  device.LogLevelSilent,
+	"error":   device.LogLevelError,
+	"verbose": device.LogLevelVerbose,
+}
+
+// configureWireguard sets up the tunnel state store and, when asked to, removes peers leaked by earlier runs
+func (p *provider) configureWireguard(ctx context.Context, blocks []providerWireguardData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	settings := providerWireguardData{}
+	if len(blocks) > 0 {
+		settings = blocks[0]
+	}
+
+	p.wgOptions = wg.TunnelOptions{
+		MTU:              int(settings.Mtu.Value),
+		KeepAlive:        int(settings.KeepAlive.Value),
+		LogLevel:         device.LogLevelError,
+		HandshakeTimeout: time.Duration(settings.HandshakeTimeout.Value) * time.Second,
+	}
+	if !settings.LogLevel.Null {
+		p.wgOptions.LogLevel = wireguardLogLevels[strings.ToLower(settings.LogLevel.Value)]
+	}
+
+	// Persisting is opt-in, either explicitly or by pointing at a state file
+	persist := settings.PersistState.Value || (settings.PersistState.Null && !settings.StateFile.Null && settings.StateFile.Value != "")
+	if !persist {
+		return diags
+	}
+
+	path := wg.DefaultStatePath()
+	if !settings.StateFile.Null && settings.StateFile.Value != "" {
+		path = settings.StateFile.Value
+	}
+	p.wgStore = &wg.StateStore{Path: path}
+
+	if !settings.CleanupStalePeers.Value {
+		return diags
+	}
+
+	orgs := map[string]bool{}
+	if p.org != nil {
+		orgs[p.org.Id] = true
+	} else if len(p.orgs) == 1 {
+		orgs[p.orgs[0].Id] = true
+	}
+	states, err := p.wgStore.All()
+	if err != nil {
+		diags.AddWarning("Could not read wireguard state", err.Error())
+		return diags
+	}
+	for _, state := range states {
+		orgs[state.Org] = true
+	}
+
+	for org := range orgs {
+		removed, err := wg.CleanStalePeers(ctx, org, p.client, p.wgStore)
+		if err != nil {
+			diags.AddWarning("Could not clean up stale wireguard peers", err.Error())
+			continue
+		}
+		if len(removed) > 0 {
+			diags.AddWarning("Removed stale wireguard peers", fmt.Sprintf("Removed %s", strings.Join(removed, ", ")))
+		}
+	}
+	return diags
+}
+
+// tunnel brings up a wireguard tunnel into org's private network, shared with any concurrent callers for the same
+// region. Callers must call Down when they are done, which keeps the peer around for the next run when state is persisted.
+func (p provider) tunnel(ctx context.Context, orgId string, region string) (*wg.Tunnel, error) {
+	return p.tunnels.Establish(ctx, orgId, region, p.token, p.client, wg.EstablishOptions{
+		Store:  p.wgStore,
+		Email:  p.user.Email,
+		Tunnel: p.wgOptions,
+	})
+}
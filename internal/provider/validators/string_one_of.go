@@ -0,0 +1,56 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// stringOneOfValidator is an attribute validator that ensures a types.StringType
+// attribute is set to one of a fixed list of values. Comparison is case
+// insensitive because the fly api uses upper case enums while terraform
+// configuration conventionally uses lower case.
+type stringOneOfValidator struct {
+	Values []string
+}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Value must be one of %s", strings.Join(v.Values, ", "))
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Value must be one of `%s`", strings.Join(v.Values, "`, `"))
+}
+
+// Validate runs the logic of the validator. Unknown and null values are
+// skipped, Required takes care of the latter.
+func (v stringOneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var str types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &str)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if str.Unknown || str.Null {
+		return
+	}
+
+	for _, value := range v.Values {
+		if strings.EqualFold(value, str.Value) {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid value", fmt.Sprintf("%q is not valid. %s", str.Value, v.Description(ctx)))
+}
+
+func StringOneOf(values ...string) stringOneOfValidator {
+	return stringOneOfValidator{
+		Values: values,
+	}
+}
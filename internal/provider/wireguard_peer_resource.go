@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"dov.dev/fly/fly-provider/internal/wg"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.ResourceType = flyWireguardPeerResourceType{}
+var _ tfsdk.Resource = flyWireguardPeerResource{}
+
+type flyWireguardPeerResourceType struct{}
+
+type flyWireguardPeerResource struct {
+	provider provider
+}
+
+type flyWireguardPeerResourceData struct {
+	Id         types.String `tfsdk:"id"`
+	Org        types.String `tfsdk:"org"`
+	Region     types.String `tfsdk:"region"`
+	Name       types.String `tfsdk:"name"`
+	Network    types.String `tfsdk:"network"`
+	PublicKey  types.String `tfsdk:"public_key"`
+	PrivateKey types.String `tfsdk:"private_key"`
+	PeerIp     types.String `tfsdk:"peer_ip"`
+	EndpointIp types.String `tfsdk:"endpoint_ip"`
+	Dns        types.String `tfsdk:"dns"`
+	Config     types.String `tfsdk:"config"`
+}
+
+func (t flyWireguardPeerResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly wireguard peer resource. The key pair is generated locally and ends up in state, so treat state as secret.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of peer",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"org": {
+				MarkdownDescription: "Optional org slug or ID to operate upon, defaults to the provider org",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"region": {
+				MarkdownDescription: "Region of the gateway to peer with",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Name of peer",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"network": {
+				MarkdownDescription: "Optional custom network to peer with",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"public_key": {
+				MarkdownDescription: "Public key of peer",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"private_key": {
+				MarkdownDescription: "Private key of peer",
+				Computed:            true,
+				Sensitive:           true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"peer_ip": {
+				MarkdownDescription: "Private network address assigned to the peer",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"endpoint_ip": {
+				MarkdownDescription: "Public address of the gateway",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"dns": {
+				MarkdownDescription: "Address of the private network DNS server",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"config": {
+				MarkdownDescription: "Rendered wg-quick config",
+				Computed:            true,
+				Sensitive:           true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyWireguardPeerResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyWireguardPeerResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyWireguardPeerResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyWireguardPeerResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not detect default organization", err.Error())
+		return
+	}
+
+	public, private, err := wg.C25519pair()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to generate wireguard keys", err.Error())
+		return
+	}
+
+	peer, err := graphql.AddWireguardPeer(context.Background(), *r.provider.client, graphql.AddWireGuardPeerInput{
+		OrganizationId: orgId,
+		Region:         data.Region.Value,
+		Name:           data.Name.Value,
+		Pubkey:         public,
+		Network:        data.Network.Value,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add wireguard peer", err.Error())
+		return
+	}
+
+	state := wg.WireGuardState{
+		Org:          orgId,
+		Name:         data.Name.Value,
+		Region:       data.Region.Value,
+		LocalPublic:  public,
+		LocalPrivate: private,
+		Peer:         peer.AddWireGuardPeer,
+	}
+	cfg, err := state.TunnelConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Wireguard peer returned by the api is invalid", err.Error())
+		r.removePeer(orgId, data.Name.Value)
+		return
+	}
+	quick, err := state.QuickConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to render wireguard config", err.Error())
+		r.removePeer(orgId, data.Name.Value)
+		return
+	}
+
+	org := data.Org
+	if org.Unknown || org.Null {
+		org = types.String{Value: orgId}
+	}
+
+	data = flyWireguardPeerResourceData{
+		Id:         types.String{Value: data.Name.Value},
+		Org:        org,
+		Region:     data.Region,
+		Name:       data.Name,
+		Network:    data.Network,
+		PublicKey:  types.String{Value: public},
+		PrivateKey: types.String{Value: private},
+		PeerIp:     types.String{Value: peer.AddWireGuardPeer.Peerip},
+		EndpointIp: types.String{Value: peer.AddWireGuardPeer.Endpointip},
+		Dns:        types.String{Value: cfg.DNS.String()},
+		Config:     types.String{Value: quick},
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyWireguardPeerResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyWireguardPeerResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	query, err := graphql.WireguardPeerQuery(context.Background(), *r.provider.client, orgId, data.Name.Value)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	// Keys are generated locally and never change, so only drift on the server side assigned values
+	data.PeerIp = types.String{Value: query.Organization.WireGuardPeer.Peerip}
+	data.Region = types.String{Value: query.Organization.WireGuardPeer.Region}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyWireguardPeerResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError("The fly api does not allow updating wireguard peers once created", "Try deleting and then recreating the peer with new options")
+	return
+}
+
+func (r flyWireguardPeerResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyWireguardPeerResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	err = r.removePeer(orgId, data.Name.Value)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Delete wireguard peer failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// removePeer deregisters a peer, also used to avoid leaking a peer when Create fails halfway
+func (r flyWireguardPeerResource) removePeer(orgId string, name string) error {
+	_, err := graphql.RemoveWireguardPeer(context.Background(), *r.provider.client, graphql.RemoveWireGuardPeerInput{
+		OrganizationId: orgId,
+		Name:           name,
+	})
+	return err
+}
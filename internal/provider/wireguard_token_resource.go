@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"dov.dev/fly/fly-provider/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.ResourceType = flyWireguardTokenResourceType{}
+var _ tfsdk.Resource = flyWireguardTokenResource{}
+
+type flyWireguardTokenResourceType struct{}
+
+type flyWireguardTokenResource struct {
+	provider provider
+}
+
+type flyWireguardTokenResourceData struct {
+	Id      types.String `tfsdk:"id"`
+	Org     types.String `tfsdk:"org"`
+	Name    types.String `tfsdk:"name"`
+	Token   types.String `tfsdk:"token"`
+	Keepers types.Map    `tfsdk:"keepers"`
+}
+
+func (t flyWireguardTokenResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Fly delegated wireguard token resource. Delegated tokens can only manage wireguard peers in their organization.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "ID of token, same as name",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"org": {
+				MarkdownDescription: "Optional org slug or ID to operate upon, defaults to the provider org",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Name of token",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"token": {
+				MarkdownDescription: "The delegated token",
+				Computed:            true,
+				Sensitive:           true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"keepers": {
+				MarkdownDescription: "Arbitrary values that rotate the token when changed",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t flyWireguardTokenResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return flyWireguardTokenResource{
+		provider: provider,
+	}, diags
+}
+
+func (r flyWireguardTokenResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data flyWireguardTokenResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not detect default organization", err.Error())
+		return
+	}
+
+	q, err := graphql.CreateDelegatedWireGuardToken(context.Background(), *r.provider.client, orgId, data.Name.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create delegated wireguard token", err.Error())
+		return
+	}
+
+	if data.Org.Unknown || data.Org.Null {
+		data.Org = types.String{Value: orgId}
+	}
+	data.Id = types.String{Value: data.Name.Value}
+	data.Token = types.String{Value: q.CreateDelegatedWireGuardToken.Token}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r flyWireguardTokenResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data flyWireguardTokenResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	query, err := graphql.DelegatedWireGuardTokensQuery(context.Background(), *r.provider.client, orgId)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Read: query failed", err.Error())
+		return
+	}
+
+	// The api never hands the token back, so all we can check is that it hasn't been revoked
+	for _, token := range query.Organization.DelegatedWireGuardTokens.Nodes {
+		if token.Name == data.Name.Value {
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r flyWireguardTokenResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	resp.Diagnostics.AddError("The fly api does not allow updating delegated tokens once created", "Try deleting and then recreating the token with new options")
+	return
+}
+
+func (r flyWireguardTokenResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data flyWireguardTokenResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	orgId, err := r.provider.orgId(data.Org)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not resolve organization", err.Error())
+		return
+	}
+
+	_, err = graphql.DeleteDelegatedWireGuardToken(context.Background(), *r.provider.client, orgId, data.Name.Value)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Revoke delegated wireguard token failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
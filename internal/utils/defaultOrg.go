@@ -4,19 +4,53 @@ import (
 	"context"
 	"dov.dev/fly/fly-provider/graphql"
 	"errors"
+	"fmt"
 	graphql2 "github.com/Khan/genqlient/graphql"
 )
 
+// ListOrgs walks every page of the organizations connection
+func ListOrgs(client graphql2.Client) ([]graphql.OrgsQueryOrganizationsOrganizationConnectionNodesOrganization, error) {
+	var orgs []graphql.OrgsQueryOrganizationsOrganizationConnectionNodesOrganization
+	cursor := ""
+	for {
+		queryresp, err := graphql.OrgsQuery(context.Background(), client, cursor)
+		if err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, queryresp.Organizations.Nodes...)
+		if !queryresp.Organizations.PageInfo.HasNextPage {
+			return orgs, nil
+		}
+		cursor = queryresp.Organizations.PageInfo.EndCursor
+	}
+}
+
 func GetDefaultOrg(client graphql2.Client) (*graphql.OrgsQueryOrganizationsOrganizationConnectionNodesOrganization, error) {
-	queryresp, err := graphql.OrgsQuery(context.Background(), client)
+	orgs, err := ListOrgs(client)
 	if err != nil {
 		return nil, err
 	}
-	if len(queryresp.Organizations.Nodes) > 1 {
+	if len(orgs) > 1 {
 		return nil, errors.New("organization is ambiguous. Your account has more than one organization, you must specify which to use")
 	}
-	if len(queryresp.Organizations.Nodes) < 1 {
+	if len(orgs) < 1 {
 		return nil, errors.New("no organizations to choose from. This error should not be reachable, if you find it please file an issue")
 	}
-	return &queryresp.Organizations.Nodes[0], nil
+	return &orgs[0], nil
+}
+
+// ResolveOrg looks up an organization by slug, falling back to treating the value as an ID
+func ResolveOrg(client graphql2.Client, slugOrId string) (*graphql.OrgQueryOrganization, error) {
+	queryresp, err := graphql.OrgQuery(context.Background(), client, "", slugOrId)
+	if err == nil && queryresp.Organization.Id != "" {
+		return &queryresp.Organization, nil
+	}
+	queryresp, err = graphql.OrgQuery(context.Background(), client, slugOrId, "")
+	if err != nil {
+		return nil, err
+	}
+	if queryresp.Organization.Id == "" {
+		return nil, fmt.Errorf("could not find an organization with slug or ID %q", slugOrId)
+	}
+	return &queryresp.Organization, nil
 }
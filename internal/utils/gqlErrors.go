@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// IsUnauthorized reports whether err was caused by the fly api rejecting our token. genqlient does not expose the
+// http status code, so we have to match on the error it builds for non 200 responses as well as on graphql errors
+// tagged with an UNAUTHORIZED code.
+func IsUnauthorized(err error) bool {
+	if err == nil {
+		return false
+	}
+	var errList gqlerror.List
+	if errors.As(err, &errList) {
+		for _, e := range errList {
+			if code, ok := e.Extensions["code"].(string); ok && code == "UNAUTHORIZED" {
+				return true
+			}
+		}
+		return false
+	}
+	return strings.HasPrefix(err.Error(), "returned error 401")
+}
+
+// IsNotFound reports whether err is the graphql error the fly api returns when the requested object doesn't exist
+func IsNotFound(err error) bool {
+	var errList gqlerror.List
+	if errors.As(err, &errList) {
+		for _, e := range errList {
+			if code, ok := e.Extensions["code"].(string); ok && code == "NOT_FOUND" {
+				return true
+			}
+			if strings.HasPrefix(e.Message, "Could not resolve") || strings.HasPrefix(e.Message, "Could not find") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	graphql2 "github.com/Khan/genqlient/graphql"
+)
+
+// ListOrgMembers walks every page of the members of an organization
+func ListOrgMembers(client graphql2.Client, orgId string) ([]graphql.OrgMemberFragment, error) {
+	var members []graphql.OrgMemberFragment
+	cursor := ""
+	for {
+		queryresp, err := graphql.OrgMembersQuery(context.Background(), client, orgId, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range queryresp.Organization.Members.Edges {
+			members = append(members, edge.OrgMemberFragment)
+		}
+		if !queryresp.Organization.Members.PageInfo.HasNextPage {
+			return members, nil
+		}
+		cursor = queryresp.Organization.Members.PageInfo.EndCursor
+	}
+}
+
+// ListOrgInvitations walks every page of the pending and redeemed invitations of an organization
+func ListOrgInvitations(client graphql2.Client, orgId string) ([]graphql.OrgInvitationFragment, error) {
+	var invitations []graphql.OrgInvitationFragment
+	cursor := ""
+	for {
+		queryresp, err := graphql.OrgInvitationsQuery(context.Background(), client, orgId, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range queryresp.Organization.Invitations.Nodes {
+			invitations = append(invitations, node.OrgInvitationFragment)
+		}
+		if !queryresp.Organization.Invitations.PageInfo.HasNextPage {
+			return invitations, nil
+		}
+		cursor = queryresp.Organization.Invitations.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,81 @@
+package wg
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"fmt"
+	rawgql "github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// CleanStalePeers removes terraform owned peers in org that were registered from this host but are no longer backed
+// by usable cached state, e.g. because a run crashed before it could save them. Cached state that the api reports as
+// invalid is dropped too. Peers belonging to other users or machines are never touched. Returns the removed peer names.
+func CleanStalePeers(ctx context.Context, org string, client *rawgql.Client, store *StateStore) ([]string, error) {
+	states, err := store.All()
+	if err != nil {
+		return nil, err
+	}
+
+	var cachedIps []string
+	for _, state := range states {
+		if state.Org == org {
+			cachedIps = append(cachedIps, state.Peer.Peerip)
+		}
+	}
+
+	invalid := map[string]bool{}
+	if len(cachedIps) > 0 {
+		resp, err := graphql.ValidateWireGuardPeers(ctx, *client, cachedIps)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range resp.ValidateWireGuardPeers.InvalidPeerIps {
+			invalid[ip] = true
+		}
+	}
+
+	keep := map[string]bool{}
+	for key, state := range states {
+		if state.Org != org {
+			continue
+		}
+		if invalid[state.Peer.Peerip] {
+			tflog.Info(ctx, "dropping invalid cached wireguard peer "+state.Name)
+			if err := store.Delete(key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		keep[state.Name] = true
+	}
+
+	viewer, err := graphql.ViewerQuery(ctx, *client)
+	if err != nil {
+		return nil, err
+	}
+	prefix := peerPrefix(viewer.Viewer.Email) + "-"
+	suffix := "-" + hostTag(store)
+
+	peers, err := graphql.WireguardPeersQuery(ctx, *client, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for _, peer := range peers.Organization.WireGuardPeers.Nodes {
+		if !strings.HasPrefix(peer.Name, prefix) || !strings.HasSuffix(peer.Name, suffix) || keep[peer.Name] {
+			continue
+		}
+		_, err := graphql.RemoveWireguardPeer(ctx, *client, graphql.RemoveWireGuardPeerInput{
+			OrganizationId: org,
+			Name:           peer.Name,
+		})
+		if err != nil {
+			return removed, fmt.Errorf("removing stale wireguard peer %s: %w", peer.Name, err)
+		}
+		removed = append(removed, peer.Name)
+	}
+	return removed, nil
+}
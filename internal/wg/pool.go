@@ -0,0 +1,90 @@
+package wg
+
+import (
+	"context"
+	"sync"
+
+	rawgql "github.com/Khan/genqlient/graphql"
+)
+
+// Pool shares one tunnel per org, region and workspace between concurrent callers in the same process. Terraform reads
+// data sources in parallel, without it each would bring up its own device with the same cached key, or register the
+// same peer name twice on a cold cache.
+type Pool struct {
+	mu      sync.Mutex
+	tunnels map[string]*pooledTunnel
+}
+
+// establish brings up pooled tunnels, tests swap it out to avoid the api
+var establish = Establish
+
+type pooledTunnel struct {
+	key    string
+	ready  chan struct{}
+	tunnel *Tunnel
+	err    error
+	refs   int
+}
+
+// Establish returns the pooled tunnel for the key, bringing it up with the package level Establish if nobody holds it.
+// Every successful call must be paired with a Down on the returned tunnel, the last one tears it down.
+func (p *Pool) Establish(ctx context.Context, org string, region string, token string, client *rawgql.Client, opts EstablishOptions) (*Tunnel, error) {
+	if opts.Workspace == "" {
+		opts.Workspace = CurrentWorkspace()
+	}
+	key := StateKey(org, region, opts.Workspace)
+
+	p.mu.Lock()
+	if p.tunnels == nil {
+		p.tunnels = map[string]*pooledTunnel{}
+	}
+	entry, ok := p.tunnels[key]
+	if ok {
+		entry.refs++
+		p.mu.Unlock()
+		select {
+		case <-entry.ready:
+		case <-ctx.Done():
+			p.release(entry)
+			return nil, ctx.Err()
+		}
+		if entry.err != nil {
+			return nil, entry.err
+		}
+		return entry.tunnel, nil
+	}
+	entry = &pooledTunnel{key: key, ready: make(chan struct{}), refs: 1}
+	p.tunnels[key] = entry
+	p.mu.Unlock()
+
+	tunnel, err := establish(ctx, org, region, token, client, opts)
+	// Waiters only read the result after ready is closed, but release reads it under the lock at any time
+	p.mu.Lock()
+	if err != nil {
+		delete(p.tunnels, key)
+		entry.err = err
+	} else {
+		tunnel.pool = p
+		tunnel.pooled = entry
+		entry.tunnel = tunnel
+	}
+	p.mu.Unlock()
+	close(entry.ready)
+	return tunnel, err
+}
+
+// release drops a reference and reports whether it was the last one, in which case the entry leaves the pool and the
+// caller owns the teardown
+func (p *Pool) release(entry *pooledTunnel) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry.refs--
+	if entry.refs > 0 {
+		return false
+	}
+	if p.tunnels[entry.key] == entry {
+		delete(p.tunnels, entry.key)
+	}
+	// A waiter that gave up before the tunnel came up can't tear it down, the establishing caller still holds a ref
+	return entry.tunnel != nil
+}
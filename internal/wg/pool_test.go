@@ -0,0 +1,134 @@
+package wg
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	rawgql "github.com/Khan/genqlient/graphql"
+)
+
+// fakeEstablish stands in for Establish, holding every call until release is closed so callers pile up on the pool
+func fakeEstablish(t *testing.T, err error) (calls *int32, release chan struct{}) {
+	t.Helper()
+	calls = new(int32)
+	release = make(chan struct{})
+	prev := establish
+	establish = func(ctx context.Context, org string, region string, token string, client *rawgql.Client, opts EstablishOptions) (*Tunnel, error) {
+		atomic.AddInt32(calls, 1)
+		<-release
+		if err != nil {
+			return nil, err
+		}
+		return &Tunnel{State: &WireGuardState{Org: org, Region: region}}, nil
+	}
+	t.Cleanup(func() { establish = prev })
+	return calls, release
+}
+
+func establishConcurrently(pool *Pool, n int) ([]*Tunnel, []error) {
+	tunnels := make([]*Tunnel, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tunnels[i], errs[i] = pool.Establish(context.Background(), "org", "ewr", "token", nil, EstablishOptions{Workspace: "default"})
+		}(i)
+	}
+	wg.Wait()
+	return tunnels, errs
+}
+
+func poolSize(pool *Pool) int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return len(pool.tunnels)
+}
+
+func TestPoolSharesTunnel(t *testing.T) {
+	calls, release := fakeEstablish(t, nil)
+	pool := &Pool{}
+
+	done := make(chan struct{})
+	var tunnels []*Tunnel
+	var errs []error
+	go func() {
+		tunnels, errs = establishConcurrently(pool, 8)
+		close(done)
+	}()
+	close(release)
+	<-done
+
+	if n := atomic.LoadInt32(calls); n != 1 {
+		t.Fatalf("expected one tunnel to be established, got %d", n)
+	}
+	for i := range tunnels {
+		if errs[i] != nil {
+			t.Fatalf("caller %d: %v", i, errs[i])
+		}
+		if tunnels[i] != tunnels[0] {
+			t.Fatalf("caller %d got a different tunnel", i)
+		}
+	}
+
+	// Downs race each other too, only the last one may tear the tunnel down
+	var wg sync.WaitGroup
+	for _, tunnel := range tunnels[1:] {
+		wg.Add(1)
+		go func(tunnel *Tunnel) {
+			defer wg.Done()
+			if err := tunnel.Down(); err != nil {
+				t.Error(err)
+			}
+		}(tunnel)
+	}
+	wg.Wait()
+	if poolSize(pool) != 1 {
+		t.Fatal("tunnel left the pool while still in use")
+	}
+	if err := tunnels[0].Down(); err != nil {
+		t.Fatal(err)
+	}
+	if poolSize(pool) != 0 {
+		t.Fatal("tunnel still pooled after the last Down")
+	}
+
+	// The next caller brings up a fresh one
+	if _, err := pool.Establish(context.Background(), "org", "ewr", "token", nil, EstablishOptions{Workspace: "default"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(calls); n != 2 {
+		t.Fatalf("expected a second tunnel to be established, got %d calls", n)
+	}
+}
+
+func TestPoolSharesFailure(t *testing.T) {
+	errConnect := errors.New("no handshake")
+	calls, release := fakeEstablish(t, errConnect)
+	pool := &Pool{}
+
+	done := make(chan struct{})
+	var errs []error
+	go func() {
+		_, errs = establishConcurrently(pool, 4)
+		close(done)
+	}()
+	close(release)
+	<-done
+
+	for i, err := range errs {
+		if !errors.Is(err, errConnect) {
+			t.Fatalf("caller %d: expected %v, got %v", i, errConnect, err)
+		}
+	}
+	if n := atomic.LoadInt32(calls); n < 1 {
+		t.Fatal("establish was never called")
+	}
+	if poolSize(pool) != 0 {
+		t.Fatal("failed tunnel left in the pool")
+	}
+}
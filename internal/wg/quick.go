@@ -0,0 +1,52 @@
+package wg
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+var quickTemplate = template.Must(template.New("wg-quick").Parse(`[Interface]
+PrivateKey = {{.PrivateKey}}
+Address = {{.Address}}
+DNS = {{.DNS}}
+
+[Peer]
+PublicKey = {{.PublicKey}}
+AllowedIPs = {{.AllowedIPs}}
+Endpoint = {{.Endpoint}}
+PersistentKeepalive = {{.KeepAlive}}
+`))
+
+// QuickConfig renders the state as a wg-quick config file. Addressing is taken
+// from TunnelConfig so peers handed to people behave exactly like the
+// provider's own tunnel.
+func (s *WireGuardState) QuickConfig() (string, error) {
+	cfg, err := s.TunnelConfig()
+	if err != nil {
+		return "", err
+	}
+
+	keepAlive := cfg.KeepAlive
+	if keepAlive == 0 {
+		// Same default flyctl writes, keeps NAT mappings alive for idle peers
+		keepAlive = 15
+	}
+
+	ones, _ := cfg.LocalNetwork.Mask.Size()
+
+	buf := new(bytes.Buffer)
+	err = quickTemplate.Execute(buf, map[string]interface{}{
+		"PrivateKey": s.LocalPrivate,
+		"Address":    fmt.Sprintf("%s/%d", s.Peer.Peerip, ones),
+		"DNS":        cfg.DNS.String(),
+		"PublicKey":  s.Peer.Pubkey,
+		"AllowedIPs": cfg.RemoteNetwork.String(),
+		"Endpoint":   cfg.Endpoint,
+		"KeepAlive":  keepAlive,
+	})
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
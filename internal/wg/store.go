@@ -0,0 +1,135 @@
+package wg
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// StateStore caches tunnel state (keys plus the peer payload) on disk so a peer can be reused across terraform runs
+// instead of registering a new one every time. Entries are keyed by StateKey.
+type StateStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// DefaultStatePath is where tunnel state lives when the provider config doesn't say otherwise
+func DefaultStatePath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "fly-provider", "wireguard.json")
+}
+
+// StateKey namespaces cached state so workspaces and regions never share a peer
+func StateKey(org string, region string, workspace string) string {
+	return strings.Join([]string{org, region, workspace}, "/")
+}
+
+// CurrentWorkspace returns the terraform workspace the provider is running in. Terraform doesn't tell providers, so
+// this mirrors how the cli itself picks it: TF_WORKSPACE first, then the environment file in the working directory.
+func CurrentWorkspace() string {
+	if ws := os.Getenv("TF_WORKSPACE"); ws != "" {
+		return ws
+	}
+	dataDir := os.Getenv("TF_DATA_DIR")
+	if dataDir == "" {
+		dataDir = ".terraform"
+	}
+	if b, err := ioutil.ReadFile(filepath.Join(dataDir, "environment")); err == nil {
+		if ws := strings.TrimSpace(string(b)); ws != "" {
+			return ws
+		}
+	}
+	return "default"
+}
+
+func (s *StateStore) read() (map[string]*WireGuardState, error) {
+	states := map[string]*WireGuardState{}
+	b, err := ioutil.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return states, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &states); err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
+func (s *StateStore) write(states map[string]*WireGuardState) error {
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0700); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write then rename so a crashed run never leaves a half written file behind
+	tmp, err := ioutil.TempFile(filepath.Dir(s.Path), ".wireguard-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// Load returns the cached state for key, or nil if there is none
+func (s *StateStore) Load(key string) (*WireGuardState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	states, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return states[key], nil
+}
+
+// All returns every cached state
+func (s *StateStore) All() (map[string]*WireGuardState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.read()
+}
+
+func (s *StateStore) Save(key string, state *WireGuardState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	states, err := s.read()
+	if err != nil {
+		return err
+	}
+	states[key] = state
+	return s.write(states)
+}
+
+func (s *StateStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	states, err := s.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := states[key]; !ok {
+		return nil
+	}
+	delete(states, key)
+	return s.write(states)
+}
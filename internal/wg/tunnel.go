@@ -4,8 +4,11 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	cryptorand "crypto/rand"
+	"crypto/sha256"
 	"dov.dev/fly/fly-provider/graphql"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	rawgql "github.com/Khan/genqlient/graphql"
@@ -17,10 +20,12 @@ import (
 	"golang.zx2c4.com/wireguard/device"
 	"golang.zx2c4.com/wireguard/tun"
 	"golang.zx2c4.com/wireguard/tun/netstack"
-	"math/rand"
 	"net"
 	"net/http"
 	"net/netip"
+	"os"
+	"strings"
+	"time"
 )
 
 type PrivateKey device.NoisePrivateKey
@@ -65,15 +70,35 @@ func (pk PublicKey) ToHex() string {
 	return buf.String()
 }
 
-//FIXME(dov): we should not be panicing anywhere in here
+var (
+	// ErrInvalidKey is returned when a key isn't a base64 encoded curve25519 key
+	ErrInvalidKey = errors.New("invalid noise key")
+	// ErrInvalidPeerIP is returned when the api hands back a peer address that isn't a 6PN address
+	ErrInvalidPeerIP = errors.New("invalid peer ip")
+)
+
+// ConfigError describes which part of a WireGuardState couldn't be turned into a tunnel config
+type ConfigError struct {
+	Field string
+	Value string
+	Err   error
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("martian %s %q: %s", e.Field, e.Value, e.Err)
+}
+
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
 
 func (pk *PrivateKey) UnmarshalText(text []byte) error {
 	buf, err := base64.StdEncoding.DecodeString(string(text))
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %s", ErrInvalidKey, err)
 	}
 	if len(buf) != device.NoisePrivateKeySize {
-		return errors.New("invalid noise private key")
+		return fmt.Errorf("%w: private key must be %d bytes", ErrInvalidKey, device.NoisePrivateKeySize)
 	}
 
 	copy(pk[:], buf)
@@ -83,10 +108,10 @@ func (pk *PrivateKey) UnmarshalText(text []byte) error {
 func (pk *PublicKey) UnmarshalText(text []byte) error {
 	buf, err := base64.StdEncoding.DecodeString(string(text))
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %s", ErrInvalidKey, err)
 	}
 	if len(buf) != device.NoisePublicKeySize {
-		return errors.New("invalid noise private key")
+		return fmt.Errorf("%w: public key must be %d bytes", ErrInvalidKey, device.NoisePublicKeySize)
 	}
 
 	copy(pk[:], buf)
@@ -100,48 +125,53 @@ type WireGuardState struct {
 	LocalPublic  string
 	LocalPrivate string
 	DNS          string
-	Token        string
+	Token        string `json:"-"`
 	Peer         graphql.AddWireguardPeerAddWireGuardPeerAddWireGuardPeerPayload
 }
 
-func (s *WireGuardState) TunnelConfig() *Config {
+// TunnelConfig derives the tunnel addressing from the peer payload. The local network is the peer's /120, the remote
+// network is the org's /48 and DNS lives at ::3 of that /48.
+func (s *WireGuardState) TunnelConfig() (*Config, error) {
 	skey := PrivateKey{}
 	if err := skey.UnmarshalText([]byte(s.LocalPrivate)); err != nil {
-		panic(fmt.Sprintf("martian local private key: %s", err))
+		return nil, &ConfigError{Field: "local private key", Value: "<redacted>", Err: err}
 	}
 
 	pkey := PublicKey{}
 	if err := pkey.UnmarshalText([]byte(s.Peer.Pubkey)); err != nil {
-		panic(fmt.Sprintf("martian local public key: %s", err))
+		return nil, &ConfigError{Field: "peer public key", Value: s.Peer.Pubkey, Err: err}
 	}
 
-	//fmt.Println(fmt.Sprintf("%s/120", s.Peer.Peerip))
-	_, lnet, err := net.ParseCIDR(fmt.Sprintf("%s/120", s.Peer.Peerip))
-	if err != nil {
-		panic(fmt.Sprintf("martian local public: %s/120: %s", s.Peer.Peerip, err))
+	peerIP := net.ParseIP(s.Peer.Peerip)
+	if peerIP == nil || peerIP.To4() != nil {
+		return nil, &ConfigError{Field: "peer ip", Value: s.Peer.Peerip, Err: ErrInvalidPeerIP}
 	}
 
-	raddr := net.ParseIP(s.Peer.Peerip).To16()
-	for i := 6; i < 16; i++ {
-		raddr[i] = 0
+	if s.Peer.Endpointip == "" {
+		return nil, &ConfigError{Field: "endpoint ip", Value: s.Peer.Endpointip, Err: ErrInvalidPeerIP}
 	}
 
-	_, rnet, _ := net.ParseCIDR(fmt.Sprintf("%s/48", raddr))
+	lnet := &net.IPNet{IP: peerIP.Mask(net.CIDRMask(120, 128)), Mask: net.CIDRMask(120, 128)}
 
-	raddr[15] = 3
-	dns := net.ParseIP(raddr.String())
+	raddr := make(net.IP, net.IPv6len)
+	copy(raddr, peerIP.To16())
+	for i := 6; i < 16; i++ {
+		raddr[i] = 0
+	}
+	rnet := &net.IPNet{IP: raddr.Mask(net.CIDRMask(48, 128)), Mask: net.CIDRMask(48, 128)}
 
-	wgl := *lnet
-	wgr := *rnet
+	dns := make(net.IP, net.IPv6len)
+	copy(dns, raddr)
+	dns[15] = 3
 
 	return &Config{
 		LocalPrivateKey: skey,
-		LocalNetwork:    &wgl,
+		LocalNetwork:    lnet,
 		RemotePublicKey: pkey,
-		RemoteNetwork:   &wgr,
-		Endpoint:        s.Peer.Endpointip + ":51820",
+		RemoteNetwork:   rnet,
+		Endpoint:        net.JoinHostPort(s.Peer.Endpointip, "51820"),
 		DNS:             dns,
-	}
+	}, nil
 }
 
 type Tunnel struct {
@@ -152,13 +182,85 @@ type Tunnel struct {
 	State     *WireGuardState
 	Config    *Config
 	apiClient *rawgql.Client
+	store     *StateStore
+	pool      *Pool
+	pooled    *pooledTunnel
 
 	wscancel func()
 	resolv   *net.Resolver
 }
 
-func doConnect(ctx context.Context, state *WireGuardState) (*Tunnel, error) {
-	cfg := state.TunnelConfig()
+// TunnelOptions tunes the wireguard device, zero values fall back to defaults
+type TunnelOptions struct {
+	MTU       int
+	KeepAlive int
+	// LogLevel is one of the device.LogLevel constants, logs are sent to tflog
+	LogLevel int
+	// HandshakeTimeout bounds how long each endpoint address gets to complete a handshake
+	HandshakeTimeout time.Duration
+}
+
+const defaultHandshakeTimeout = 10 * time.Second
+
+// tflogLogger routes wireguard-go logs through tflog. Anything written to stdout corrupts the plugin protocol.
+func tflogLogger(ctx context.Context, level int) *device.Logger {
+	logger := &device.Logger{Verbosef: device.DiscardLogf, Errorf: device.DiscardLogf}
+	if level >= device.LogLevelVerbose {
+		logger.Verbosef = func(format string, args ...interface{}) {
+			tflog.Debug(ctx, "wireguard: "+fmt.Sprintf(format, args...))
+		}
+	}
+	if level >= device.LogLevelError {
+		logger.Errorf = func(format string, args ...interface{}) {
+			tflog.Error(ctx, "wireguard: "+fmt.Sprintf(format, args...))
+		}
+	}
+	return logger
+}
+
+// waitForHandshake dials the private DNS server through the tunnel. The first packet kicks off the handshake, so a
+// successful dial means the endpoint answered and the private network is reachable.
+func waitForHandshake(ctx context.Context, gNet *netstack.Net, dnsIP net.IP, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c, err := gNet.DialContext(ctx, "tcp", net.JoinHostPort(dnsIP.String(), "53"))
+	if err != nil {
+		return err
+	}
+	return c.Close()
+}
+
+// tryEndpoints points the peer at each gateway address in turn until a handshake completes, some of them may be
+// unreachable from where terraform runs. It returns the address that worked.
+func tryEndpoints(ctx context.Context, addrs []string, use func(addr string) error, handshake func() error) (string, error) {
+	var handshakeErr error
+	for _, addr := range addrs {
+		if err := use(addr); err != nil {
+			return "", err
+		}
+		tflog.Debug(ctx, "trying wireguard endpoint "+addr)
+		handshakeErr = handshake()
+		if handshakeErr == nil {
+			return addr, nil
+		}
+		tflog.Warn(ctx, fmt.Sprintf("no handshake with wireguard endpoint %s: %s", addr, handshakeErr))
+	}
+	return "", handshakeErr
+}
+
+func doConnect(ctx context.Context, state *WireGuardState, opts TunnelOptions) (*Tunnel, error) {
+	cfg, err := state.TunnelConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.MTU = opts.MTU
+	cfg.KeepAlive = opts.KeepAlive
+	cfg.LogLevel = opts.LogLevel
+
+	handshakeTimeout := opts.HandshakeTimeout
+	if handshakeTimeout == 0 {
+		handshakeTimeout = defaultHandshakeTimeout
+	}
 
 	localNetworkIp, _ := netip.AddrFromSlice(cfg.LocalNetwork.IP)
 	localIPs := []netip.Addr{localNetworkIp}
@@ -169,42 +271,59 @@ func doConnect(ctx context.Context, state *WireGuardState) (*Tunnel, error) {
 		mtu = device.DefaultMTU
 	}
 
-	tunDev, gNet, err := netstack.CreateNetTUN(localIPs, []netip.Addr{dnsIP}, mtu)
+	endpointHost, endpointPort, err := net.SplitHostPort(cfg.Endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	endpointHost, endpointPort, err := net.SplitHostPort(cfg.Endpoint)
+	endpointIPs, err := net.DefaultResolver.LookupIP(ctx, "ip", endpointHost)
 	if err != nil {
 		return nil, err
 	}
+	if len(endpointIPs) == 0 {
+		return nil, fmt.Errorf("no addresses found for wireguard endpoint %s", endpointHost)
+	}
 
-	endpointIPs, err := net.LookupIP(endpointHost)
+	tunDev, gNet, err := netstack.CreateNetTUN(localIPs, []netip.Addr{dnsIP}, mtu)
 	if err != nil {
 		return nil, err
 	}
 
-	endpointIP := endpointIPs[rand.Intn(len(endpointIPs))]
-	endpointAddr := net.JoinHostPort(endpointIP.String(), endpointPort)
-
-	wgDev := device.NewDevice(tunDev, conn.NewDefaultBind(), device.NewLogger(cfg.LogLevel, "(fly-ssh) "))
+	wgDev := device.NewDevice(tunDev, conn.NewDefaultBind(), tflogLogger(ctx, cfg.LogLevel))
 
 	wgConf := bytes.NewBuffer(nil)
 
 	fmt.Fprintf(wgConf, "private_key=%s\n", cfg.LocalPrivateKey.ToHex())
 	fmt.Fprintf(wgConf, "public_key=%s\n", cfg.RemotePublicKey.ToHex())
-	fmt.Fprintf(wgConf, "endpoint=%s\n", endpointAddr)
 	fmt.Fprintf(wgConf, "allowed_ip=%s\n", cfg.RemoteNetwork)
 	fmt.Fprintf(wgConf, "persistent_keepalive_interval=%d\n", cfg.KeepAlive)
 
 	if err := wgDev.IpcSetOperation(bufio.NewReader(wgConf)); err != nil {
+		wgDev.Close()
 		return nil, err
 	}
 	err = wgDev.Up()
 	if err != nil {
+		wgDev.Close()
 		return nil, err
 	}
 
+	endpointAddrs := make([]string, 0, len(endpointIPs))
+	for _, endpointIP := range endpointIPs {
+		endpointAddrs = append(endpointAddrs, net.JoinHostPort(endpointIP.String(), endpointPort))
+	}
+	useEndpoint := func(addr string) error {
+		update := fmt.Sprintf("public_key=%s\nupdate_only=true\nendpoint=%s\n", cfg.RemotePublicKey.ToHex(), addr)
+		return wgDev.IpcSetOperation(bufio.NewReader(strings.NewReader(update)))
+	}
+	handshake := func() error {
+		return waitForHandshake(ctx, gNet, cfg.DNS, handshakeTimeout)
+	}
+	if _, err := tryEndpoints(ctx, endpointAddrs, useEndpoint, handshake); err != nil {
+		wgDev.Close()
+		return nil, fmt.Errorf("could not complete a wireguard handshake with any address of %s: %w", endpointHost, err)
+	}
+
 	return &Tunnel{
 		dev:    wgDev,
 		tun:    tunDev,
@@ -216,7 +335,6 @@ func doConnect(ctx context.Context, state *WireGuardState) (*Tunnel, error) {
 		resolv: &net.Resolver{
 			PreferGo: true,
 			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
-				fmt.Println("resolver.Dial", network, address)
 				return gNet.DialContext(ctx, "tcp", net.JoinHostPort(dnsIP.String(), "53"))
 			},
 		},
@@ -227,20 +345,26 @@ func (t *Tunnel) Resolver() *net.Resolver {
 	return t.resolv
 }
 
-func C25519pair() (string, string) {
+// DialContext dials addr on the private network, .internal names are resolved through the private DNS server
+func (t *Tunnel) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	return t.net.DialContext(ctx, network, addr)
+}
+
+// C25519pair generates a base64 encoded public, private key pair
+func C25519pair() (string, string, error) {
 	var private [32]byte
-	_, err := rand.Read(private[:])
+	_, err := cryptorand.Read(private[:])
 	if err != nil {
-		panic(fmt.Sprintf("reading from random: %s", err))
+		return "", "", fmt.Errorf("reading from random: %w", err)
 	}
 
 	public, err := curve25519.X25519(private[:], curve25519.Basepoint)
 	if err != nil {
-		panic(fmt.Sprintf("can't mult: %s", err))
+		return "", "", fmt.Errorf("can't mult: %w", err)
 	}
 
 	return base64.StdEncoding.EncodeToString(public),
-		base64.StdEncoding.EncodeToString(private[:])
+		base64.StdEncoding.EncodeToString(private[:]), nil
 }
 
 type dialContextType = func(ctx context.Context, network, addr string) (net.Conn, error)
@@ -257,7 +381,6 @@ type Transport struct {
 }
 
 func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
-	fmt.Println(req)
 	req.Header.Add("Authorization", "Bearer "+t.token)
 	return t.underlyingTransport.RoundTrip(req)
 }
@@ -268,7 +391,6 @@ func (t *Tunnel) NewHttpClient() Client {
 		token:               t.State.Token,
 		underlyingTransport: http.DefaultTransport,
 		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
-			println("we do get here right?")
 			return t.net.DialContext(ctx, network, addr)
 		},
 	}
@@ -276,20 +398,27 @@ func (t *Tunnel) NewHttpClient() Client {
 	return Client{HttpClient: http.Client{Transport: &transport}}
 }
 
+// Down tears down the device. Peers that were cached in a StateStore are kept for the next run, anything else is
+// removed from the org.
 func (t *Tunnel) Down() error {
-	_, err := graphql.RemoveWireguardPeer(context.Background(), *t.apiClient, graphql.RemoveWireGuardPeerInput{
-		OrganizationId: t.State.Org,
-		Name:           t.State.Name,
-	})
-	if err != nil {
-		// Silently ignore this error for now. It's not the end of the world if the peer isn't removed
+	if t.pool != nil && !t.pool.release(t.pooled) {
+		return nil
 	}
-	err = t.dev.Down()
-	if err != nil {
-		return err
+	var peerErr error
+	if t.store == nil && t.apiClient != nil {
+		_, peerErr = graphql.RemoveWireguardPeer(context.Background(), *t.apiClient, graphql.RemoveWireGuardPeerInput{
+			OrganizationId: t.State.Org,
+			Name:           t.State.Name,
+		})
+		if peerErr != nil {
+			peerErr = fmt.Errorf("removing wireguard peer %s: %w", t.State.Name, peerErr)
+		}
 	}
-	t.dev, t.tun, t.net = nil, nil, nil
-	return nil
+	if t.dev != nil {
+		t.dev.Close()
+		t.dev, t.tun, t.net = nil, nil, nil
+	}
+	return peerErr
 }
 
 func (t *Tunnel) QueryDNS(ctx context.Context, msg *dns.Msg) (*dns.Msg, error) {
@@ -313,10 +442,129 @@ func (t *Tunnel) QueryDNS(ctx context.Context, msg *dns.Msg) (*dns.Msg, error) {
 	return r, err
 }
 
-func Establish(ctx context.Context, org string, region string, username string, token string, client *rawgql.Client) (*Tunnel, error) {
-	peerName := "terraform-tunnel-" + username
+// sanitizePeerName maps s onto the characters fly accepts in peer names, alphanumerics and dashes
+func sanitizePeerName(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('-')
+		}
+	}
+	return b.String()
+}
+
+// peerPrefix is shared by every peer the provider registers for a user, the janitor uses it to recognise them
+func peerPrefix(email string) string {
+	return "terraform-tunnel-" + sanitizePeerName(email)
+}
+
+// hostTag distinguishes peers registered from different machines (or state files) so parallel runs never collide
+func hostTag(store *StateStore) string {
+	hostname, _ := os.Hostname()
+	path := ""
+	if store != nil {
+		path = store.Path
+	}
+	sum := sha256.Sum256([]byte(hostname + "\x00" + path))
+	return hex.EncodeToString(sum[:])[:8]
+}
+
+// peerNameFor derives a peer name from the token owner's email, the workspace and the host. Throwaway peers get a
+// random suffix on top so concurrent runs without a store never register the same name, and the janitor, which only
+// matches the bare host tag, leaves them alone.
+func peerNameFor(email string, workspace string, store *StateStore) (string, error) {
+	name := peerPrefix(email) + "-" + sanitizePeerName(workspace) + "-" + hostTag(store)
+	if store != nil {
+		return name, nil
+	}
+	var nonce [4]byte
+	if _, err := cryptorand.Read(nonce[:]); err != nil {
+		return "", err
+	}
+	return name + "-" + hex.EncodeToString(nonce[:]), nil
+}
+
+// EstablishOptions controls how Establish finds or registers its peer
+type EstablishOptions struct {
+	// Store caches the peer between runs. When nil a fresh peer is registered and Down removes it again.
+	Store *StateStore
+	// Workspace namespaces the cached peer, defaults to CurrentWorkspace
+	Workspace string
+	// Email of the token owner, which peer names are derived from. Looked up when empty.
+	Email  string
+	Tunnel TunnelOptions
+}
+
+// validState reports whether cached state can still be used, i.e. it parses and the api still knows the peer
+func validState(ctx context.Context, client *rawgql.Client, state *WireGuardState) bool {
+	if _, err := state.TunnelConfig(); err != nil {
+		return false
+	}
+	resp, err := graphql.ValidateWireGuardPeers(ctx, *client, []string{state.Peer.Peerip})
+	if err != nil {
+		return false
+	}
+	for _, ip := range resp.ValidateWireGuardPeers.ValidPeerIps {
+		if ip == state.Peer.Peerip {
+			return true
+		}
+	}
+	return false
+}
+
+func Establish(ctx context.Context, org string, region string, token string, client *rawgql.Client, opts EstablishOptions) (*Tunnel, error) {
+	workspace := opts.Workspace
+	if workspace == "" {
+		workspace = CurrentWorkspace()
+	}
+	key := StateKey(org, region, workspace)
+
+	if opts.Store != nil {
+		cached, err := opts.Store.Load(key)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("ignoring unreadable wireguard state %s: %s", opts.Store.Path, err))
+		} else if cached != nil {
+			if validState(ctx, client, cached) {
+				tflog.Info(ctx, "reusing wireguard peer "+cached.Name)
+				cached.Token = token
+				tunnel, err := doConnect(ctx, cached, opts.Tunnel)
+				if err == nil {
+					tunnel.apiClient = client
+					tunnel.store = opts.Store
+					return tunnel, nil
+				}
+				tflog.Warn(ctx, fmt.Sprintf("cached wireguard peer %s failed to connect: %s", cached.Name, err))
+			}
+			// Stale, get rid of it both locally and remotely before registering a replacement under the same name
+			_, _ = graphql.RemoveWireguardPeer(ctx, *client, graphql.RemoveWireGuardPeerInput{
+				OrganizationId: cached.Org,
+				Name:           cached.Name,
+			})
+			if err := opts.Store.Delete(key); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	email := opts.Email
+	if email == "" {
+		viewer, err := graphql.ViewerQuery(ctx, *client)
+		if err != nil {
+			return nil, err
+		}
+		email = viewer.Viewer.Email
+	}
+	peerName, err := peerNameFor(email, workspace, opts.Store)
+	if err != nil {
+		return nil, err
+	}
 	tflog.Info(ctx, peerName)
-	public, private := C25519pair()
+	public, private, err := C25519pair()
+	if err != nil {
+		return nil, err
+	}
 
 	peer, err := graphql.AddWireguardPeer(ctx, *client, graphql.AddWireGuardPeerInput{
 		OrganizationId: org,
@@ -338,10 +586,24 @@ func Establish(ctx context.Context, org string, region string, username string,
 		Peer:         peer.AddWireGuardPeer,
 		Token:        token,
 	}
-	tunnel, err := doConnect(ctx, &state)
+	tunnel, err := doConnect(ctx, &state, opts.Tunnel)
 
 	if err != nil {
+		// Don't leak the peer we just registered
+		_, _ = graphql.RemoveWireguardPeer(ctx, *client, graphql.RemoveWireGuardPeerInput{
+			OrganizationId: org,
+			Name:           peerName,
+		})
 		return nil, err
 	}
+	tunnel.apiClient = client
+
+	if opts.Store != nil {
+		if err := opts.Store.Save(key, &state); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("could not cache wireguard state in %s: %s", opts.Store.Path, err))
+		} else {
+			tunnel.store = opts.Store
+		}
+	}
 	return tunnel, nil
 }
@@ -0,0 +1,227 @@
+package wg
+
+import (
+	"context"
+	"dov.dev/fly/fly-provider/graphql"
+	"errors"
+	"testing"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+func testState(t *testing.T) WireGuardState {
+	t.Helper()
+	_, private, err := C25519pair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	peerPublic, _, err := C25519pair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return WireGuardState{
+		LocalPrivate: private,
+		Peer: graphql.AddWireguardPeerAddWireGuardPeerAddWireGuardPeerPayload{
+			Endpointip: "1.2.3.4",
+			Peerip:     "fdaa:0:1:a7b:81:0:a:102",
+			Pubkey:     peerPublic,
+		},
+	}
+}
+
+func TestTunnelConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *WireGuardState)
+		field  string
+		err    error
+	}{
+		{
+			name:   "bad private key",
+			modify: func(s *WireGuardState) { s.LocalPrivate = "not base64!" },
+			field:  "local private key",
+			err:    ErrInvalidKey,
+		},
+		{
+			name:   "short private key",
+			modify: func(s *WireGuardState) { s.LocalPrivate = "AAAA" },
+			field:  "local private key",
+			err:    ErrInvalidKey,
+		},
+		{
+			name:   "bad peer pubkey",
+			modify: func(s *WireGuardState) { s.Peer.Pubkey = "%%%" },
+			field:  "peer public key",
+			err:    ErrInvalidKey,
+		},
+		{
+			name:   "empty peer pubkey",
+			modify: func(s *WireGuardState) { s.Peer.Pubkey = "" },
+			field:  "peer public key",
+			err:    ErrInvalidKey,
+		},
+		{
+			name:   "ipv4 peer ip",
+			modify: func(s *WireGuardState) { s.Peer.Peerip = "10.0.0.1" },
+			field:  "peer ip",
+			err:    ErrInvalidPeerIP,
+		},
+		{
+			name:   "garbage peer ip",
+			modify: func(s *WireGuardState) { s.Peer.Peerip = "fdaa::zz" },
+			field:  "peer ip",
+			err:    ErrInvalidPeerIP,
+		},
+		{
+			name:   "empty endpoint",
+			modify: func(s *WireGuardState) { s.Peer.Endpointip = "" },
+			field:  "endpoint ip",
+			err:    ErrInvalidPeerIP,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testState(t)
+			tt.modify(&state)
+
+			cfg, err := state.TunnelConfig()
+			if err == nil {
+				t.Fatalf("expected an error, got config %+v", cfg)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected %v, got %v", tt.err, err)
+			}
+			var configErr *ConfigError
+			if !errors.As(err, &configErr) {
+				t.Fatalf("expected a *ConfigError, got %T", err)
+			}
+			if configErr.Field != tt.field {
+				t.Errorf("expected field %q, got %q", tt.field, configErr.Field)
+			}
+		})
+	}
+}
+
+func TestTunnelConfigAddressing(t *testing.T) {
+	state := testState(t)
+
+	cfg, err := state.TunnelConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.LocalNetwork.String(); got != "fdaa:0:1:a7b:81:0:a:100/120" {
+		t.Errorf("local network %s", got)
+	}
+	if got := cfg.RemoteNetwork.String(); got != "fdaa:0:1::/48" {
+		t.Errorf("remote network %s", got)
+	}
+	if got := cfg.DNS.String(); got != "fdaa:0:1::3" {
+		t.Errorf("dns %s", got)
+	}
+	if cfg.Endpoint != "1.2.3.4:51820" {
+		t.Errorf("endpoint %s", cfg.Endpoint)
+	}
+}
+
+func TestC25519pair(t *testing.T) {
+	public, private, err := C25519pair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pub PublicKey
+	if err := pub.UnmarshalText([]byte(public)); err != nil {
+		t.Fatalf("public key doesn't round trip: %v", err)
+	}
+	var priv PrivateKey
+	if err := priv.UnmarshalText([]byte(private)); err != nil {
+		t.Fatalf("private key doesn't round trip: %v", err)
+	}
+
+	derived, err := curve25519.X25519(priv[:], curve25519.Basepoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(derived) != string(pub[:]) {
+		t.Error("public key doesn't belong to the private key")
+	}
+
+	_, other, err := C25519pair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == private {
+		t.Error("two calls returned the same private key")
+	}
+}
+
+func TestTryEndpoints(t *testing.T) {
+	errTimeout := errors.New("handshake timed out")
+	tests := []struct {
+		name    string
+		addrs   []string
+		dead    map[string]bool
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "first address works",
+			addrs: []string{"1.2.3.4:51820", "[2a09::1]:51820"},
+			want:  "1.2.3.4:51820",
+		},
+		{
+			name:  "skips a dead first address",
+			addrs: []string{"1.2.3.4:51820", "[2a09::1]:51820"},
+			dead:  map[string]bool{"1.2.3.4:51820": true},
+			want:  "[2a09::1]:51820",
+		},
+		{
+			name:    "every address dead",
+			addrs:   []string{"1.2.3.4:51820", "[2a09::1]:51820"},
+			dead:    map[string]bool{"1.2.3.4:51820": true, "[2a09::1]:51820": true},
+			wantErr: errTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tried []string
+			current := ""
+			use := func(addr string) error {
+				tried = append(tried, addr)
+				current = addr
+				return nil
+			}
+			handshake := func() error {
+				if tt.dead[current] {
+					return errTimeout
+				}
+				return nil
+			}
+
+			got, err := tryEndpoints(context.Background(), tt.addrs, use, handshake)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected endpoint %q, got %q", tt.want, got)
+			}
+			if tt.wantErr == nil && tried[len(tried)-1] != tt.want {
+				t.Errorf("peer left pointing at %q", tried[len(tried)-1])
+			}
+		})
+	}
+}
+
+func TestTryEndpointsStopsOnConfigError(t *testing.T) {
+	errConfig := errors.New("bad endpoint")
+	use := func(addr string) error { return errConfig }
+	handshake := func() error {
+		t.Fatal("handshake attempted after the endpoint couldn't be set")
+		return nil
+	}
+	if _, err := tryEndpoints(context.Background(), []string{"1.2.3.4:51820"}, use, handshake); !errors.Is(err, errConfig) {
+		t.Fatalf("expected %v, got %v", errConfig, err)
+	}
+}
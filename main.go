@@ -4,6 +4,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"dov.dev/fly/fly-provider/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -29,6 +30,21 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "tunnel":
+			if err := runTunnel(os.Args[2:]); err != nil {
+				log.Fatal(err.Error())
+			}
+			return
+		case "doctor":
+			if err := runDoctor(os.Args[2:]); err != nil {
+				log.Fatal(err.Error())
+			}
+			return
+		}
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -0,0 +1,9 @@
+//go:build tools
+
+package main
+
+// Keeps the docs generator used by go:generate and the graphql client generator in go.mod
+import (
+	_ "github.com/Khan/genqlient"
+	_ "github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs"
+)
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"dov.dev/fly/fly-provider/internal/utils"
+	"dov.dev/fly/fly-provider/internal/wg"
+	"github.com/Khan/genqlient/graphql"
+)
+
+// apiClient builds the same authenticated graphql client the provider uses, the token comes from FLY_TOKEN
+func apiClient(ctx context.Context) (graphql.Client, string, error) {
+	token := os.Getenv("FLY_TOKEN")
+	if token == "" {
+		return nil, "", errors.New("FLY_TOKEN is not set")
+	}
+	h := http.Client{Timeout: 60 * time.Second, Transport: &utils.Transport{UnderlyingTransport: http.DefaultTransport, Token: token, Ctx: ctx}}
+	return graphql.NewClient("https://api.fly.io/graphql", &h), token, nil
+}
+
+// resolveOrgFlag maps an --org flag value onto an org ID, falling back to the account's only org like the provider does
+func resolveOrgFlag(client graphql.Client, org string) (string, error) {
+	if org != "" {
+		resolved, err := utils.ResolveOrg(client, org)
+		if err != nil {
+			return "", err
+		}
+		return resolved.Id, nil
+	}
+	defaultOrg, err := utils.GetDefaultOrg(client)
+	if err != nil {
+		return "", err
+	}
+	return defaultOrg.Id, nil
+}
+
+// runTunnel brings up the provider's wireguard tunnel and exposes it locally until interrupted, either as an http
+// proxy supporting CONNECT or as a plain tcp forward
+func runTunnel(args []string) error {
+	fs := flag.NewFlagSet("tunnel", flag.ExitOnError)
+	org := fs.String("org", "", "org slug or ID, defaults to your only org")
+	region := fs.String("region", "", "region of the wireguard gateway to peer with")
+	listen := fs.String("listen", "127.0.0.1:1080", "local address to listen on")
+	forward := fs.String("forward", "", "forward connections to this private address, e.g. _api.internal:4280, instead of running a proxy")
+	stateFile := fs.String("state-file", "", "where to cache tunnel state, by default a throwaway peer is registered")
+	workspace := fs.String("workspace", "tunnel", "namespaces the cached peer, kept apart from terraform workspaces by default")
+	fs.Parse(args)
+
+	if *region == "" {
+		return errors.New("--region is required")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, token, err := apiClient(ctx)
+	if err != nil {
+		return err
+	}
+	orgId, err := resolveOrgFlag(client, *org)
+	if err != nil {
+		return err
+	}
+
+	opts := wg.EstablishOptions{Workspace: *workspace}
+	if *stateFile != "" {
+		opts.Store = &wg.StateStore{Path: *stateFile}
+	}
+	tunnel, err := wg.Establish(ctx, orgId, *region, token, &client, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tunnel.Down(); err != nil {
+			log.Println(err.Error())
+		}
+	}()
+
+	listener, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	if *forward != "" {
+		log.Printf("forwarding %s to %s through peer %s", listener.Addr(), *forward, tunnel.State.Name)
+		err = serveForward(ctx, listener, tunnel, *forward)
+	} else {
+		log.Printf("http proxy listening on %s through peer %s", listener.Addr(), tunnel.State.Name)
+		err = http.Serve(listener, tunnelProxy{tunnel: tunnel})
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+func serveForward(ctx context.Context, listener net.Listener, tunnel *wg.Tunnel, target string) error {
+	for {
+		local, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer local.Close()
+			remote, err := tunnel.DialContext(ctx, "tcp", target)
+			if err != nil {
+				log.Printf("dial %s: %s", target, err)
+				return
+			}
+			defer remote.Close()
+			pipe(local, remote)
+		}()
+	}
+}
+
+// tunnelProxy is a minimal forward proxy. CONNECT requests are spliced onto a tunnel connection, anything else is
+// sent on with a transport that dials through the tunnel.
+type tunnelProxy struct {
+	tunnel *wg.Tunnel
+}
+
+func (p tunnelProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodConnect {
+		p.connect(w, r)
+		return
+	}
+
+	if !r.URL.IsAbs() {
+		http.Error(w, "this is a proxy, requests need an absolute url", http.StatusBadRequest)
+		return
+	}
+	transport := &http.Transport{DialContext: p.tunnel.DialContext, DisableKeepAlives: true}
+	r.RequestURI = ""
+	resp, err := transport.RoundTrip(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	io.Copy(w, resp.Body)
+}
+
+func (p tunnelProxy) connect(w http.ResponseWriter, r *http.Request) {
+	remote, err := p.tunnel.DialContext(r.Context(), "tcp", r.Host)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer remote.Close()
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+	local, buf, err := hijacker.Hijack()
+	if err != nil {
+		return
+	}
+	defer local.Close()
+
+	fmt.Fprint(local, "HTTP/1.1 200 Connection established\r\n\r\n")
+	pipe(&bufferedConn{Conn: local, r: buf.Reader}, remote)
+}
+
+// bufferedConn keeps bytes the http server already read past the CONNECT request
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+func pipe(a net.Conn, b net.Conn) {
+	var done sync.WaitGroup
+	done.Add(2)
+	go func() {
+		defer done.Done()
+		io.Copy(a, b)
+		a.Close()
+	}()
+	go func() {
+		defer done.Done()
+		io.Copy(b, a)
+		b.Close()
+	}()
+	done.Wait()
+}